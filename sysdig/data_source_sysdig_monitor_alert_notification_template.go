@@ -0,0 +1,101 @@
+package sysdig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSysdigMonitorAlertNotificationTemplate() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorAlertNotificationTemplateRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subject": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAlertV2NotificationTemplate,
+			},
+			"prepend": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAlertV2NotificationTemplate,
+			},
+			"append": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAlertV2NotificationTemplate,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rendered_subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rendered_prepend": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rendered_append": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSysdigMonitorAlertNotificationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	variables := map[string]string{}
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		if alertV2NotificationTemplateVariableRegexp.MatchString(name) {
+			return diag.Errorf("variable %s is reserved for the template variables filled in by Sysdig", name)
+		}
+		variables[name] = value.(string)
+	}
+
+	subject := renderAlertV2NotificationTemplate(d.Get("subject").(string), variables)
+	prepend := renderAlertV2NotificationTemplate(d.Get("prepend").(string), variables)
+	appendText := renderAlertV2NotificationTemplate(d.Get("append").(string), variables)
+
+	_ = d.Set("rendered_subject", subject)
+	_ = d.Set("rendered_prepend", prepend)
+	_ = d.Set("rendered_append", appendText)
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checksum := sha256.Sum256([]byte(strings.Join([]string{subject, prepend, appendText, strings.Join(names, ",")}, "\x00")))
+	d.SetId(fmt.Sprintf("%x", checksum))
+
+	return nil
+}
+
+// renderAlertV2NotificationTemplate replaces the {{variable}} expressions defined in variables,
+// everything else is left untouched for Sysdig to fill in when sending the notification
+func renderAlertV2NotificationTemplate(text string, variables map[string]string) string {
+	return alertV2NotificationTemplateExpressionRegexp.ReplaceAllStringFunc(text, func(expression string) string {
+		name := alertV2NotificationTemplateExpressionRegexp.FindStringSubmatch(expression)[1]
+		if value, ok := variables[name]; ok {
+			return value
+		}
+		return expression
+	})
+}
@@ -0,0 +1,80 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorAlertNotificationTemplateDataSource(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorAlertNotificationTemplate(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alert_notification_template.standard", "rendered_subject", "[payments] {{__alert_name__}} is {{__alert_status__}}"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_alert_notification_template.standard", "rendered_append", "Runbook: https://runbooks.example.com/payments"),
+					resource.TestCheckResourceAttr("sysdig_monitor_alert_v2_metric.sample", "custom_notification.0.subject", "[payments] {{__alert_name__}} is {{__alert_status__}}"),
+				),
+			},
+			{
+				// unknown variables are only reported as warnings and sent verbatim
+				Config: monitorAlertNotificationTemplateWithUnknownVariable(),
+				Check:  resource.TestCheckResourceAttr("data.sysdig_monitor_alert_notification_template.standard", "rendered_subject", "{{__alert_nmae__}} is {{__alert_status__}}"),
+			},
+		},
+	})
+}
+
+func monitorAlertNotificationTemplate(name string) string {
+	return fmt.Sprintf(`
+data "sysdig_monitor_alert_notification_template" "standard" {
+	subject = "[{{team}}] {{__alert_name__}} is {{__alert_status__}}"
+	prepend = "Cluster {{kube_cluster_name}}"
+	append = "Runbook: {{ runbook }}"
+	variables = {
+		team = "payments"
+		runbook = "https://runbooks.example.com/payments"
+	}
+}
+
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+	name = "TERRAFORM TEST - METRICV2 %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	trigger_after_minutes = 15
+
+	custom_notification {
+		subject = data.sysdig_monitor_alert_notification_template.standard.rendered_subject
+		prepend = data.sysdig_monitor_alert_notification_template.standard.rendered_prepend
+		append = data.sysdig_monitor_alert_notification_template.standard.rendered_append
+	}
+}
+`, name)
+}
+
+func monitorAlertNotificationTemplateWithUnknownVariable() string {
+	return `
+data "sysdig_monitor_alert_notification_template" "standard" {
+	subject = "{{__alert_nmae__}} is {{__alert_status__}}"
+}
+`
+}
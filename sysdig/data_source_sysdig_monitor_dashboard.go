@@ -0,0 +1,325 @@
+package sysdig
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dashboardPanelTypeNames are the names used by the dashboard resource for each panel type
+var dashboardPanelTypeNames = map[v2.PanelType]string{
+	v2.PanelTypeTimechart:   "timechart",
+	v2.PanelTypeNumber:      "number",
+	v2.PanelTypeText:        "text",
+	v2.PanelTypeTable:       "table",
+	v2.PanelTypeToplist:     "toplist",
+	v2.PanelTypeHistogram:   "histogram",
+	v2.PanelTypeBarChart:    "bar_chart",
+	v2.PanelTypeEventStream: "event_stream",
+}
+
+// dashboardQueryUnitNames are the names used by the dashboard resource for each query unit
+var dashboardQueryUnitNames = map[v2.FormatUnit]string{
+	v2.FormatUnitPercentage: "percent",
+	v2.FormatUnitData:       "data",
+	v2.FormatUnitDataRate:   "data rate",
+	v2.FormatUnitNumber:     "number",
+	v2.FormatUnitNumberRate: "number rate",
+	v2.FormatUnitTime:       "time",
+}
+
+func dataSourceSysdigMonitorDashboard() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorDashboardRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a dashboard ID"),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"public_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"panel": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pos_x": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pos_y": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"width": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"height": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"promql": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comparator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"variable": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"share": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSysdigMonitorDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, idOk := d.GetOk("id")
+	name, nameOk := d.GetOk("name")
+	if idOk == nameOk {
+		return diag.Errorf("exactly one of id or name must be set")
+	}
+
+	var dashboardID int
+	if idOk {
+		dashboardID, err = strconv.Atoi(id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		dashboards, err := client.ListDashboards(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var found []*v2.Dashboard
+		for _, dashboard := range dashboards {
+			if dashboard.Name == name.(string) {
+				found = append(found, dashboard)
+			}
+		}
+		if len(found) == 0 {
+			return diag.Errorf("dashboard %q not found", name)
+		}
+		if len(found) > 1 {
+			return diag.Errorf("found %d dashboards named %q, use the id to select one", len(found), name)
+		}
+		dashboardID = found[0].ID
+	}
+
+	dashboard, err := client.GetDashboard(ctx, dashboardID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(dashboard.ID))
+	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("description", dashboard.Description)
+	_ = d.Set("owner", dashboard.Username)
+	_ = d.Set("shared", dashboard.Shared)
+	_ = d.Set("public", dashboard.Public)
+	_ = d.Set("public_token", dashboard.PublicToken)
+	_ = d.Set("version", dashboard.Version)
+
+	var panels []map[string]interface{}
+	for _, panel := range dashboard.Panels {
+		panels = append(panels, dashboardPanelToDataSource(panel, dashboard.Layout))
+	}
+	_ = d.Set("panel", panels)
+
+	var scopes []map[string]interface{}
+	for _, scope := range dashboard.ScopeExpressionList {
+		dScope, err := scopeToResourceData(scope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		scopes = append(scopes, dScope)
+	}
+	_ = d.Set("scope", scopes)
+
+	var shares []map[string]interface{}
+	for _, share := range dashboard.SharingSettings {
+		dShare, err := shareToResourceData(share)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		shares = append(shares, dShare)
+	}
+	_ = d.Set("share", shares)
+
+	return nil
+}
+
+// dashboardPanelToDataSource only exposes the attributes common to all the panels, so that panels
+// of types not supported by the dashboard resource can still be read
+func dashboardPanelToDataSource(panel *v2.Panels, layout []*v2.Layout) map[string]interface{} {
+	panelType, ok := dashboardPanelTypeNames[panel.Type]
+	if !ok {
+		panelType = string(panel.Type)
+	}
+
+	res := map[string]interface{}{
+		"id":          panel.ID,
+		"name":        panel.Name,
+		"description": panel.Description,
+		"type":        panelType,
+	}
+
+	for _, l := range layout {
+		if l.PanelID == panel.ID {
+			res["pos_x"] = l.X
+			res["pos_y"] = l.Y
+			res["width"] = l.W
+			res["height"] = l.H
+		}
+	}
+
+	if panel.MarkdownSource != nil {
+		res["content"] = *panel.MarkdownSource
+	}
+
+	var queries []map[string]interface{}
+	for _, query := range panel.AdvancedQueries {
+		unit, ok := dashboardQueryUnitNames[query.Format.Unit]
+		if !ok {
+			unit = string(query.Format.Unit)
+		}
+		queries = append(queries, map[string]interface{}{
+			"promql": query.Query,
+			"unit":   unit,
+		})
+	}
+	res["query"] = queries
+
+	return res
+}
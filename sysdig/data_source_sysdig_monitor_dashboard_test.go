@@ -0,0 +1,69 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorDashboardDataSource(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+	name := rText()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorDashboardDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_dashboard.by_name", "id", "sysdig_monitor_dashboard.dashboard", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_dashboard.by_id", "name", "sysdig_monitor_dashboard.dashboard", "name"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboard.by_id", "panel.#", "1"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboard.by_id", "panel.0.type", "timechart"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboard.by_id", "panel.0.width", "12"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboard.by_id", "panel.0.query.0.unit", "percent"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboards.matching", "dashboards.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_dashboards.matching", "ids.0", "sysdig_monitor_dashboard.dashboard", "id"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_dashboards.public", "dashboards.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func monitorDashboardDataSource(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sysdig_monitor_dashboard" "by_name" {
+	name = sysdig_monitor_dashboard.dashboard.name
+}
+
+data "sysdig_monitor_dashboard" "by_id" {
+	id = sysdig_monitor_dashboard.dashboard.id
+}
+
+data "sysdig_monitor_dashboards" "matching" {
+	name_regex = "^TERRAFORM TEST - METRIC %s$"
+	depends_on = [sysdig_monitor_dashboard.dashboard]
+}
+
+data "sysdig_monitor_dashboards" "public" {
+	name_regex = "^TERRAFORM TEST - METRIC %s$"
+	public = true
+	depends_on = [sysdig_monitor_dashboard.dashboard]
+}
+`, minimumDashboard(name), name, name)
+}
@@ -0,0 +1,144 @@
+package sysdig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSysdigMonitorDashboards() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorDashboardsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shared": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dashboards": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSysdigMonitorDashboardsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegexp *regexp.Regexp
+	if value, ok := d.GetOk("name_regex"); ok {
+		nameRegexp = regexp.MustCompile(value.(string))
+	}
+	owner := d.Get("owner").(string)
+	// shared and public are only used as filters when set, false being a valid filter value
+	filterShared := !d.GetRawConfig().GetAttr("shared").IsNull()
+	filterPublic := !d.GetRawConfig().GetAttr("public").IsNull()
+
+	dashboards, err := client.ListDashboards(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := []string{}
+	var result []map[string]interface{}
+	for _, dashboard := range dashboards {
+		if nameRegexp != nil && !nameRegexp.MatchString(dashboard.Name) {
+			continue
+		}
+		if owner != "" && dashboard.Username != owner {
+			continue
+		}
+		if filterShared && dashboard.Shared != d.Get("shared").(bool) {
+			continue
+		}
+		if filterPublic && dashboard.Public != d.Get("public").(bool) {
+			continue
+		}
+
+		id := strconv.Itoa(dashboard.ID)
+		ids = append(ids, id)
+		result = append(result, map[string]interface{}{
+			"id":          id,
+			"name":        dashboard.Name,
+			"description": dashboard.Description,
+			"owner":       dashboard.Username,
+			"shared":      dashboard.Shared,
+			"public":      dashboard.Public,
+			"version":     dashboard.Version,
+		})
+	}
+
+	_ = d.Set("ids", ids)
+	_ = d.Set("dashboards", result)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+
+	return nil
+}
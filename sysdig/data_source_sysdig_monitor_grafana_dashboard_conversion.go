@@ -0,0 +1,348 @@
+package sysdig
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+)
+
+type grafanaDashboard struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Panels      []grafanaPanel `json:"panels"`
+	Templating  struct {
+		List []grafanaVariable `json:"list"`
+	} `json:"templating"`
+}
+
+type grafanaPanel struct {
+	Type        string `json:"type"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	GridPos     struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+		W int `json:"w"`
+		H int `json:"h"`
+	} `json:"gridPos"`
+	Targets []struct {
+		Expr         string `json:"expr"`
+		LegendFormat string `json:"legendFormat"`
+		Hide         bool   `json:"hide"`
+	} `json:"targets"`
+	FieldConfig struct {
+		Defaults struct {
+			Unit     string `json:"unit"`
+			Decimals *int   `json:"decimals"`
+		} `json:"defaults"`
+	} `json:"fieldConfig"`
+	Options struct {
+		Content string `json:"content"`
+	} `json:"options"`
+	// Content is where text panels keep their text before Grafana 7
+	Content string `json:"content"`
+	// Panels are the panels of a collapsed row
+	Panels []grafanaPanel `json:"panels"`
+}
+
+type grafanaVariable struct {
+	Name    string      `json:"name"`
+	Label   string      `json:"label"`
+	Type    string      `json:"type"`
+	Query   interface{} `json:"query"`
+	Multi   bool        `json:"multi"`
+	Current struct {
+		Value interface{} `json:"value"`
+	} `json:"current"`
+}
+
+var (
+	grafanaLabelValuesRegexp = regexp.MustCompile(`^label_values\((?:.*,)?\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\)$`)
+	grafanaVariableRegexp    = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)(?::[a-z]+)?\}|\[\[([a-zA-Z_][a-zA-Z0-9_]*)\]\]`)
+)
+
+func dataSourceSysdigMonitorGrafanaDashboardConversion() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorGrafanaDashboardConversionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"grafana_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSysdigMonitorGrafanaDashboardConversionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	grafanaJSON := d.Get("grafana_json").(string)
+
+	var grafana grafanaDashboard
+	err := json.Unmarshal([]byte(grafanaJSON), &grafana)
+	if err != nil {
+		return diag.Errorf("invalid Grafana dashboard JSON: %s", err)
+	}
+
+	dashboard, warnings := convertGrafanaDashboard(grafana)
+
+	dashboardJSON, err := json.Marshal(dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("dashboard_json", string(dashboardJSON))
+	_ = d.Set("warnings", warnings)
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(grafanaJSON))))
+
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning,
+		})
+	}
+	return diags
+}
+
+// convertGrafanaDashboard converts what has an equivalent in Sysdig, returning a warning for everything else
+func convertGrafanaDashboard(grafana grafanaDashboard) (*v2.Dashboard, []string) {
+	warnings := []string{}
+
+	dashboard := v2.NewDashboard(grafana.Title, grafana.Description)
+	dashboard.ScopeExpressionList = []*v2.ScopeExpressionList{}
+
+	for _, variable := range grafana.Templating.List {
+		scope, warning := convertGrafanaVariable(variable)
+		if warning != "" {
+			warnings = append(warnings, warning)
+			continue
+		}
+		dashboard.ScopeExpressionList = append(dashboard.ScopeExpressionList, scope)
+	}
+
+	for _, grafanaPanel := range flattenGrafanaPanels(grafana.Panels) {
+		panel, panelWarnings := convertGrafanaPanel(grafanaPanel)
+		warnings = append(warnings, panelWarnings...)
+		if panel != nil {
+			dashboard.AddPanels(panel)
+		}
+	}
+
+	return dashboard, warnings
+}
+
+// flattenGrafanaPanels replaces the rows by the panels they contain, Sysdig dashboards have no rows
+func flattenGrafanaPanels(panels []grafanaPanel) []grafanaPanel {
+	var flattened []grafanaPanel
+	for _, panel := range panels {
+		if panel.Type == "row" {
+			flattened = append(flattened, flattenGrafanaPanels(panel.Panels)...)
+			continue
+		}
+		flattened = append(flattened, panel)
+	}
+	return flattened
+}
+
+func convertGrafanaVariable(variable grafanaVariable) (*v2.ScopeExpressionList, string) {
+	multiSelect := variable.Multi
+	scope := &v2.ScopeExpressionList{
+		Operator:    "in",
+		DisplayName: variable.Name,
+		Value:       []string{},
+		IsVariable:  true,
+		Title:       variable.Label,
+		MultiSelect: &multiSelect,
+	}
+
+	query := cast.ToString(variable.Query)
+	if queryObject, ok := variable.Query.(map[string]interface{}); ok {
+		query = cast.ToString(queryObject["query"])
+	}
+
+	switch variable.Type {
+	case "query":
+		match := grafanaLabelValuesRegexp.FindStringSubmatch(strings.TrimSpace(query))
+		if match == nil {
+			return nil, fmt.Sprintf("variable %s: only label_values queries can be converted, found %q", variable.Name, query)
+		}
+		scope.Operand = match[1]
+	case "custom":
+		// custom variables have no label, their values are used as the allowed values of a variable with the same name
+		scope.Operand = variable.Name
+		for _, value := range strings.Split(query, ",") {
+			scope.Value = append(scope.Value, strings.TrimSpace(value))
+		}
+	default:
+		return nil, fmt.Sprintf("variable %s: variables of type %s cannot be converted", variable.Name, variable.Type)
+	}
+
+	current := []string{}
+	switch value := variable.Current.Value.(type) {
+	case string:
+		current = append(current, value)
+	case []interface{}:
+		current = cast.ToStringSlice(value)
+	}
+	for _, value := range current {
+		if value != "$__all" && value != "" {
+			scope.DefaultValues = append(scope.DefaultValues, value)
+		}
+	}
+
+	return scope, ""
+}
+
+func convertGrafanaPanel(grafanaPanel grafanaPanel) (*v2.Panels, []string) {
+	var warnings []string
+
+	var panel *v2.Panels
+	switch grafanaPanel.Type {
+	case "timeseries", "graph":
+		panel = newTimechartPanel(grafanaPanel.Title, grafanaPanel.Description, defaultLegendConfiguration())
+	case "stat", "singlestat":
+		panel = newNumberPanel(grafanaPanel.Title, grafanaPanel.Description)
+	case "table":
+		panel = newPanel(grafanaPanel.Title, grafanaPanel.Description, v2.PanelTypeTable)
+		panel.TableConfiguration = &v2.TableConfiguration{Columns: []v2.TableColumn{}}
+	case "text":
+		content := grafanaPanel.Options.Content
+		if content == "" {
+			content = grafanaPanel.Content
+		}
+		panel = newPanel(grafanaPanel.Title, "", v2.PanelTypeText)
+		panel.MarkdownSource = &content
+		panel.PanelTitleVisible = grafanaPanel.Title != ""
+	default:
+		return nil, []string{fmt.Sprintf("panel %q: panels of type %s cannot be converted", grafanaPanel.Title, grafanaPanel.Type)}
+	}
+
+	x, width := grafanaPanel.GridPos.X, grafanaPanel.GridPos.W
+	if width <= 0 {
+		width = 12
+	}
+	if x+width > 24 {
+		warnings = append(warnings, fmt.Sprintf("panel %q: the panel does not fit in the 24 columns of the layout and has been resized", grafanaPanel.Title))
+		width = 24 - x
+	}
+	height := grafanaPanel.GridPos.H
+	if height <= 0 {
+		height = 8
+	}
+	_, err := panel.WithLayout(x, grafanaPanel.GridPos.Y, width, height)
+	if err != nil {
+		return nil, append(warnings, fmt.Sprintf("panel %q: %s", grafanaPanel.Title, err))
+	}
+
+	if panel.Type == v2.PanelTypeText {
+		return panel, warnings
+	}
+
+	format, known := grafanaUnitFormat(grafanaPanel.FieldConfig.Defaults.Unit)
+	if !known {
+		warnings = append(warnings, fmt.Sprintf("panel %q: unit %s cannot be converted, number is used instead", grafanaPanel.Title, grafanaPanel.FieldConfig.Defaults.Unit))
+	}
+	if grafanaPanel.FieldConfig.Defaults.Decimals != nil {
+		decimals := *grafanaPanel.FieldConfig.Defaults.Decimals
+		format.Decimals = &decimals
+	}
+
+	var queries []*v2.AdvancedQueries
+	for _, target := range grafanaPanel.Targets {
+		if target.Hide || target.Expr == "" {
+			continue
+		}
+		query := v2.NewPromqlQuery(convertGrafanaPromQL(target.Expr), panel, v2.DisplayInfo{
+			TimeSeriesDisplayNameTemplate: target.LegendFormat,
+			Type:                          "lines",
+		})
+		query.Format = format
+		queries = append(queries, query)
+	}
+	if len(queries) == 0 {
+		return nil, append(warnings, fmt.Sprintf("panel %q: the panel has no PromQL query and cannot be converted", grafanaPanel.Title))
+	}
+
+	if panel.Type == v2.PanelTypeNumber && len(queries) > 1 {
+		warnings = append(warnings, fmt.Sprintf("panel %q: number panels can only have one query, only the first one is kept", grafanaPanel.Title))
+		queries = queries[:1]
+	}
+
+	_, err = panel.AddQueries(queries...)
+	if err != nil {
+		return nil, append(warnings, fmt.Sprintf("panel %q: %s", grafanaPanel.Title, err))
+	}
+
+	return panel, warnings
+}
+
+// convertGrafanaPromQL rewrites the variable syntaxes Sysdig does not support into $variable
+func convertGrafanaPromQL(expr string) string {
+	expr = grafanaVariableRegexp.ReplaceAllStringFunc(expr, func(variable string) string {
+		match := grafanaVariableRegexp.FindStringSubmatch(variable)
+		if match[1] != "" {
+			return "$" + match[1]
+		}
+		return "$" + match[2]
+	})
+	return strings.ReplaceAll(expr, "$__rate_interval", "$__interval")
+}
+
+func grafanaUnitFormat(unit string) (v2.Format, bool) {
+	switch unit {
+	case "percent":
+		return v2.NewPercentFormat(), true
+	case "percentunit":
+		format := v2.NewPercentFormat()
+		inputFormat := "0-1"
+		format.InputFormat = &inputFormat
+		return format, true
+	case "bytes", "decbytes":
+		return v2.NewDataFormat(), true
+	case "Bps", "binBps":
+		return v2.NewDataRateFormat(), true
+	case "reqps", "ops", "rps", "wps", "iops":
+		return v2.NewNumberRateFormat(), true
+	case "s", "ms", "us", "ns":
+		format := v2.NewTimeFormat()
+		inputFormat := unit
+		format.InputFormat = &inputFormat
+		return format, true
+	case "", "short", "none", "number":
+		return v2.NewNumberFormat(), true
+	default:
+		return v2.NewNumberFormat(), false
+	}
+}
@@ -0,0 +1,109 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorGrafanaDashboardConversionDataSource(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorGrafanaDashboardConversion(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sysdig_monitor_dashboard_json.converted", "version"),
+					resource.TestMatchResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "name", regexp.MustCompile("^TERRAFORM TEST - GRAFANA ")),
+					resource.TestMatchResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "dashboard_json", regexp.MustCompile(`"type":"advancedTimechart"`)),
+					resource.TestMatchResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "dashboard_json", regexp.MustCompile(`sum by \(pod\) \(rate\(container_cpu_usage_seconds_total\{namespace=~\\"\$namespace\\"\}\[\$__interval\]\)\)`)),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "warnings.#", "2"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "warnings.0", "variable datasource: variables of type datasource cannot be converted"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_grafana_dashboard_conversion.grafana", "warnings.1", `panel "Pods": panels of type piechart cannot be converted`),
+				),
+			},
+		},
+	})
+}
+
+func monitorGrafanaDashboardConversion(name string) string {
+	return fmt.Sprintf(`
+data "sysdig_monitor_grafana_dashboard_conversion" "grafana" {
+	grafana_json = jsonencode({
+		title = "TERRAFORM TEST - GRAFANA %s"
+		templating = {
+			list = [
+				{
+					name = "datasource"
+					type = "datasource"
+					query = "prometheus"
+				},
+				{
+					name = "namespace"
+					label = "Namespace"
+					type = "query"
+					multi = true
+					query = { query = "label_values(kube_pod_info, namespace)" }
+					current = { value = ["$__all"] }
+				}
+			]
+		}
+		panels = [
+			{
+				type = "row"
+				title = "CPU"
+				gridPos = { x = 0, y = 0, w = 24, h = 1 }
+				panels = []
+			},
+			{
+				type = "timeseries"
+				title = "CPU by pod"
+				gridPos = { x = 0, y = 1, w = 12, h = 8 }
+				fieldConfig = { defaults = { unit = "percentunit" } }
+				targets = [{
+					expr = "sum by (pod) (rate(container_cpu_usage_seconds_total{namespace=~\"$${namespace}\"}[$__rate_interval]))"
+					legendFormat = "{{pod}}"
+				}]
+			},
+			{
+				type = "stat"
+				title = "Pods running"
+				gridPos = { x = 12, y = 1, w = 6, h = 8 }
+				targets = [{ expr = "count(kube_pod_info{namespace=~\"$namespace\"})" }]
+			},
+			{
+				type = "text"
+				title = "Notes"
+				gridPos = { x = 18, y = 1, w = 6, h = 8 }
+				options = { content = "Converted from Grafana" }
+			},
+			{
+				type = "piechart"
+				title = "Pods"
+				gridPos = { x = 0, y = 9, w = 12, h = 8 }
+				targets = [{ expr = "count by (phase) (kube_pod_status_phase)" }]
+			}
+		]
+	})
+}
+
+resource "sysdig_monitor_dashboard_json" "converted" {
+	dashboard_json = data.sysdig_monitor_grafana_dashboard_conversion.grafana.dashboard_json
+}
+`, name)
+}
@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelCustomWebhook() *schema.Resource
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,7 +55,7 @@ func dataSourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx context.Con
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelCustomWebhookToResourceData(&nc, d)
+	err = notificationChannelCustomWebhookToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
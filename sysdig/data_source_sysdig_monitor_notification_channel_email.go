@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelEmail() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"recipients": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -40,7 +40,7 @@ func dataSourceSysdigMonitorNotificationChannelEmailRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelEmailToResourceData(&nc, d)
+	err = notificationChannelEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
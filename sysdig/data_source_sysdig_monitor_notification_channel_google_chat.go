@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelGoogleChat() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +39,7 @@ func dataSourceSysdigMonitorNotificationChannelGoogleChatRead(ctx context.Contex
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelGoogleChatToResourceData(&nc, d)
+	err = notificationChannelGoogleChatToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
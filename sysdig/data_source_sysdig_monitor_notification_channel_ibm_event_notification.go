@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelIBMEventNotification() *schema.Re
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +39,7 @@ func dataSourceSysdigMonitorNotificationChannelIBMEventNotificationRead(ctx cont
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelIBMEventNotificationToResourceData(&nc, d)
+	err = notificationChannelIBMEventNotificationToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
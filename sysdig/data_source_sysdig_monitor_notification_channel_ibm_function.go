@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelIBMFunction() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"ibm_function_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,7 +55,7 @@ func dataSourceSysdigMonitorNotificationChannelIBMFunctionRead(ctx context.Conte
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelIBMFunctionToResourceData(&nc, d)
+	err = notificationChannelIBMFunctionToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,59 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelJiraDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelJira(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "id", "sysdig_monitor_notification_channel_jira.nc_jira", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "name", "sysdig_monitor_notification_channel_jira.nc_jira", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "url", "sysdig_monitor_notification_channel_jira.nc_jira", "url"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "username", "sysdig_monitor_notification_channel_jira.nc_jira", "username"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "issue_mapping.0.project", "sysdig_monitor_notification_channel_jira.nc_jira", "issue_mapping.0.project"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_jira.nc_jira", "issue_mapping.0.issue_type", "sysdig_monitor_notification_channel_jira.nc_jira", "issue_mapping.0.issue_type"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelJira(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_jira" "nc_jira" {
+	name = "Example Channel %s - Jira"
+	url = "https://example.atlassian.net"
+	username = "sysdig@example.com"
+	api_token = "XXXXXXXXXX"
+	issue_mapping {
+		project = "OPS"
+		issue_type = "Task"
+	}
+}
+
+data "sysdig_monitor_notification_channel_jira" "nc_jira" {
+	name = sysdig_monitor_notification_channel_jira.nc_jira.name
+}
+`, name)
+}
@@ -19,11 +19,12 @@ func dataSourceSysdigMonitorNotificationChannelMSTeams() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationDataSourceSchema(),
 		}),
 	}
 }
@@ -39,10 +40,12 @@ func dataSourceSysdigMonitorNotificationChannelMSTeamsRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelMSTeamsToResourceData(&nc, d)
+	err = notificationChannelMSTeamsToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// all the sections are listed, there is no configuration to only keep the ones it lists
+	_ = d.Set("template_configuration", flattenNotificationChannelTemplateConfiguration(nc.Options.TemplateConfiguration, nil))
 
 	d.SetId(strconv.Itoa(nc.ID))
 
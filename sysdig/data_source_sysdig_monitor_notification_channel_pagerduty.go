@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelPagerduty() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"account": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +47,7 @@ func dataSourceSysdigMonitorNotificationChannelPagerdutyRead(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelPagerdutyToResourceData(&nc, d)
+	err = notificationChannelPagerdutyToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
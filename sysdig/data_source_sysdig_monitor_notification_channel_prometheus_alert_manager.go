@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelPrometheusAlertManager() *schema.
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +47,7 @@ func dataSourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead(ctx co
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelPrometheusAlertManagerToResourceData(&nc, d)
+	err = notificationChannelPrometheusAlertManagerToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,53 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelServiceNowDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelServiceNow(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_servicenow.nc_servicenow", "id", "sysdig_monitor_notification_channel_servicenow.nc_servicenow", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_servicenow.nc_servicenow", "name", "sysdig_monitor_notification_channel_servicenow.nc_servicenow", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_servicenow.nc_servicenow", "url", "sysdig_monitor_notification_channel_servicenow.nc_servicenow", "url"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_servicenow.nc_servicenow", "username", "sysdig_monitor_notification_channel_servicenow.nc_servicenow", "username"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelServiceNow(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_servicenow" "nc_servicenow" {
+	name = "Example Channel %s - ServiceNow"
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+}
+
+data "sysdig_monitor_notification_channel_servicenow" "nc_servicenow" {
+	name = sysdig_monitor_notification_channel_servicenow.nc_servicenow.name
+}
+`, name)
+}
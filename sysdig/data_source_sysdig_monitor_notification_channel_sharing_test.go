@@ -0,0 +1,103 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelSharingDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelSharing(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sysdig_monitor_notification_channel_sharing.global", "shared_with_all_teams", "true"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_notification_channel_sharing.global", "team_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.sysdig_monitor_notification_channel_sharing.teams", "shared_with_all_teams", "false"),
+					resource.TestCheckTypeSetElemAttrPair("data.sysdig_monitor_notification_channel_sharing.teams", "team_ids.*", "sysdig_monitor_team.sample", "id"),
+					resource.TestCheckTypeSetElemAttrPair("sysdig_monitor_notification_channel_email.teams", "shared_with_teams.*", "sysdig_monitor_team.sample", "id"),
+				),
+			},
+			{
+				Config: monitorNotificationChannelSharedGlobally(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_email.teams", "shared_globally", "true"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_email.teams", "shared_with_teams.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_notification_channel_email.teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelSharing(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_team" "sample" {
+	name = "sample-%s"
+	entrypoint {
+		type = "Dashboards"
+	}
+}
+
+resource "sysdig_monitor_notification_channel_email" "global" {
+	name = "%s - global"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_email" "teams" {
+	name = "%s - teams"
+	recipients = ["root@localhost.com"]
+	shared_with_teams = [sysdig_monitor_team.sample.id]
+}
+
+data "sysdig_monitor_notification_channel_sharing" "global" {
+	notification_channel_id = sysdig_monitor_notification_channel_email.global.id
+}
+
+data "sysdig_monitor_notification_channel_sharing" "teams" {
+	notification_channel_id = sysdig_monitor_notification_channel_email.teams.id
+}
+`, name, name, name)
+}
+
+func monitorNotificationChannelSharedGlobally(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_team" "sample" {
+	name = "sample-%s"
+	entrypoint {
+		type = "Dashboards"
+	}
+}
+
+resource "sysdig_monitor_notification_channel_email" "global" {
+	name = "%s - global"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_email" "teams" {
+	name = "%s - teams"
+	recipients = ["root@localhost.com"]
+	shared_globally = true
+}
+`, name, name, name)
+}
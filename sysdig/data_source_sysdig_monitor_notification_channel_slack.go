@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelSlack() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,6 +56,7 @@ func dataSourceSysdigMonitorNotificationChannelSlack() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationDataSourceSchema(),
 		}),
 	}
 }
@@ -75,6 +76,8 @@ func dataSourceSysdigMonitorNotificationChannelSlackRead(ctx context.Context, d
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// all the sections are listed, there is no configuration to only keep the ones it lists
+	_ = d.Set("template_configuration", flattenNotificationChannelTemplateConfiguration(nc.Options.TemplateConfiguration, nil))
 
 	d.SetId(strconv.Itoa(nc.ID))
 
@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelSNS() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"topics": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -40,7 +40,7 @@ func dataSourceSysdigMonitorNotificationChannelSNSRead(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelSNSToResourceData(&nc, d)
+	err = notificationChannelSNSToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelTeamEmail() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -39,7 +39,7 @@ func dataSourceSysdigMonitorNotificationChannelTeamEmailRead(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelTeamEmailToResourceData(&nc, d)
+	err = notificationChannelTeamEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
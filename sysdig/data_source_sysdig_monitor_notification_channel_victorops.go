@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelVictorOps() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"api_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,7 +43,7 @@ func dataSourceSysdigMonitorNotificationChannelVictorOpsRead(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelVictorOpsToResourceData(&nc, d)
+	err = notificationChannelVictorOpsToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,51 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelWebexDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelWebex(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "id", "sysdig_monitor_notification_channel_webex.nc_webex", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "name", "sysdig_monitor_notification_channel_webex.nc_webex", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_webex.nc_webex", "room_id", "sysdig_monitor_notification_channel_webex.nc_webex", "room_id"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelWebex(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "nc_webex" {
+	name = "Example Channel %s - Webex"
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00vXXXXXXXX"
+	bot_token = "XXXXXXXXXX"
+}
+
+data "sysdig_monitor_notification_channel_webex" "nc_webex" {
+	name = sysdig_monitor_notification_channel_webex.nc_webex.name
+}
+`, name)
+}
@@ -19,7 +19,7 @@ func dataSourceSysdigMonitorNotificationChannelWebhook() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,7 +51,7 @@ func dataSourceSysdigMonitorNotificationChannelWebhookRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelWebhookToResourceData(&nc, d)
+	err = notificationChannelWebhookToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
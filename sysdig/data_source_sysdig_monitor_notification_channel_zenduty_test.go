@@ -0,0 +1,49 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelZendutyDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelZenduty(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_zenduty.nc_zenduty", "id", "sysdig_monitor_notification_channel_zenduty.nc_zenduty", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_monitor_notification_channel_zenduty.nc_zenduty", "name", "sysdig_monitor_notification_channel_zenduty.nc_zenduty", "name"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelZenduty(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_zenduty" "nc_zenduty" {
+	name = "Example Channel %s - Zenduty"
+	routing_key = "XXXXXXXXXX"
+}
+
+data "sysdig_monitor_notification_channel_zenduty" "nc_zenduty" {
+	name = sysdig_monitor_notification_channel_zenduty.nc_zenduty.name
+}
+`, name)
+}
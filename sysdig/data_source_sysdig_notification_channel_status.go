@@ -0,0 +1,68 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSysdigNotificationChannelStatus() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigNotificationChannelStatusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"monitor", "secure"}, false),
+			},
+			"notification_channel_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataSourceSysdigNotificationChannelStatusRead reports the state of a channel. The API of Sysdig does not expose the
+// deliveries of the notification channels, so none are reported.
+func dataSourceSysdigNotificationChannelStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getNotificationChannelClientByProduct(meta.(SysdigClients), d.Get("product").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nc, err := client.GetNotificationChannelById(ctx, d.Get("notification_channel_id").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(nc.ID))
+	_ = d.Set("name", nc.Name)
+	_ = d.Set("type", nc.Type)
+	_ = d.Set("enabled", nc.Enabled)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccNotificationChannelStatusDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: notificationChannelStatus(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_notification_channel_status.sample", "name", "sysdig_monitor_notification_channel_email.sample", "name"),
+					resource.TestCheckResourceAttr("data.sysdig_notification_channel_status.sample", "type", "EMAIL"),
+					resource.TestCheckResourceAttr("data.sysdig_notification_channel_status.sample", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func notificationChannelStatus(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "sample" {
+	name = "%s - email"
+	recipients = ["root@localhost.com"]
+}
+
+data "sysdig_notification_channel_status" "sample" {
+	product = "monitor"
+	notification_channel_id = sysdig_monitor_notification_channel_email.sample.id
+}
+`, name)
+}
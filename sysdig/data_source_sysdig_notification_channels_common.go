@@ -0,0 +1,270 @@
+package sysdig
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSysdigNotificationChannelsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"types": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"share_with_current_team": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"shared_with_teams": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"shared_globally": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"notification_channels": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"enabled": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"share_with_current_team": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"shared_with_teams": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
+					"shared_globally": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"resource_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"hcl": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// getDataSourceSysdigNotificationChannelsRead lists the channels of the product of the client matching the filters,
+// all the channels being listed page by page, with the configuration of the resources managing them
+func getDataSourceSysdigNotificationChannelsRead(getClient func(SysdigClients) (v2.NotificationChannelInterface, error), resourceTypes map[string]notificationChannelResourceType) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, err := getClient(meta.(SysdigClients))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var nameRegexp *regexp.Regexp
+		if value, ok := d.GetOk("name_regex"); ok {
+			nameRegexp = regexp.MustCompile(value.(string))
+		}
+		types := map[string]bool{}
+		for _, t := range d.Get("types").(*schema.Set).List() {
+			types[t.(string)] = true
+		}
+		// enabled, share_with_current_team and shared_globally are only used as filters when set, false being a valid
+		// filter value
+		filterEnabled := !d.GetRawConfig().GetAttr("enabled").IsNull()
+		filterShared := !d.GetRawConfig().GetAttr("share_with_current_team").IsNull()
+		filterGlobal := !d.GetRawConfig().GetAttr("shared_globally").IsNull()
+		var teams []int
+		for _, team := range d.Get("shared_with_teams").(*schema.Set).List() {
+			teams = append(teams, team.(int))
+		}
+
+		channels, err := client.ListNotificationChannels(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		ids := []string{}
+		var result []map[string]interface{}
+		for _, channel := range channels {
+			shared := channel.TeamID != nil
+			teamIDs := notificationChannelTeamIDs(&channel)
+			global := !shared && len(teamIDs) == 0
+			if nameRegexp != nil && !nameRegexp.MatchString(channel.Name) {
+				continue
+			}
+			if len(types) > 0 && !types[channel.Type] {
+				continue
+			}
+			if filterEnabled && channel.Enabled != d.Get("enabled").(bool) {
+				continue
+			}
+			if filterShared && shared != d.Get("share_with_current_team").(bool) {
+				continue
+			}
+			if filterGlobal && global != d.Get("shared_globally").(bool) {
+				continue
+			}
+			if !containsAllTeams(teamIDs, teams) {
+				continue
+			}
+
+			// the channels of the types without resource, if any, can still be referenced by ID
+			var resourceName, hcl string
+			if resourceType, ok := resourceTypes[channel.Type]; ok {
+				resourceName = resourceType.name
+				hcl, err = notificationChannelHCL(&channel, resourceType)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			id := strconv.Itoa(channel.ID)
+			ids = append(ids, id)
+			result = append(result, map[string]interface{}{
+				"id":                      id,
+				"name":                    channel.Name,
+				"type":                    channel.Type,
+				"enabled":                 channel.Enabled,
+				"share_with_current_team": shared,
+				"shared_with_teams":       teamIDs,
+				"shared_globally":         global,
+				"resource_type":           resourceName,
+				"hcl":                     hcl,
+			})
+		}
+
+		_ = d.Set("ids", ids)
+		_ = d.Set("notification_channels", result)
+		d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+
+		return nil
+	}
+}
+
+func dataSourceSysdigNotificationChannelSharingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"notification_channel_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"shared_with_all_teams": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"team_ids": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+	}
+}
+
+// getDataSourceSysdigNotificationChannelSharingRead reports the teams which can use a channel, the channel being
+// shared with a single team, with a set of teams or with all the teams
+func getDataSourceSysdigNotificationChannelSharingRead(getClient func(SysdigClients) (v2.NotificationChannelInterface, error)) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, err := getClient(meta.(SysdigClients))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		nc, err := client.GetNotificationChannelById(ctx, d.Get("notification_channel_id").(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		teamIDs := []int{}
+		if nc.TeamID != nil {
+			teamIDs = append(teamIDs, *nc.TeamID)
+		}
+		for _, teamID := range notificationChannelTeamIDs(&nc) {
+			if nc.TeamID == nil || teamID != *nc.TeamID {
+				teamIDs = append(teamIDs, teamID)
+			}
+		}
+
+		d.SetId(strconv.Itoa(nc.ID))
+		_ = d.Set("name", nc.Name)
+		_ = d.Set("type", nc.Type)
+		_ = d.Set("shared_with_all_teams", len(teamIDs) == 0)
+		_ = d.Set("team_ids", teamIDs)
+
+		return nil
+	}
+}
+
+// notificationChannelTeamIDs returns the teams a channel is shared with through teamIds, empty when it is shared
+// with the current team or with all the teams
+func notificationChannelTeamIDs(nc *v2.NotificationChannel) []int {
+	if nc.TeamIDs == nil {
+		return []int{}
+	}
+	return *nc.TeamIDs
+}
+
+// containsAllTeams reports whether all the wanted teams are in teamIDs
+func containsAllTeams(teamIDs, wanted []int) bool {
+	teams := map[int]bool{}
+	for _, id := range teamIDs {
+		teams[id] = true
+	}
+	for _, id := range wanted {
+		if !teams[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,210 @@
+package sysdig
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationChannelResourceType is the resource managing a type of notification channel, used to generate the
+// configuration of the channels created in the UI, such as the ones requiring OAuth
+type notificationChannelResourceType struct {
+	name           string
+	resource       func() *schema.Resource
+	toResourceData func(*v2.NotificationChannel, *schema.ResourceData) error
+}
+
+var monitorNotificationChannelResourceTypes = map[string]notificationChannelResourceType{
+	NOTIFICATION_CHANNEL_TYPE_EMAIL:                    {"sysdig_monitor_notification_channel_email", resourceSysdigMonitorNotificationChannelEmail, notificationChannelEmailToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_AMAZON_SNS:               {"sysdig_monitor_notification_channel_sns", resourceSysdigMonitorNotificationChannelSNS, notificationChannelSNSToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_OPSGENIE:                 {"sysdig_monitor_notification_channel_opsgenie", resourceSysdigMonitorNotificationChannelOpsGenie, notificationChannelOpsGenieToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_VICTOROPS:                {"sysdig_monitor_notification_channel_victorops", resourceSysdigMonitorNotificationChannelVictorOps, notificationChannelVictorOpsToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_WEBHOOK:                  {"sysdig_monitor_notification_channel_webhook", resourceSysdigMonitorNotificationChannelWebhook, notificationChannelWebhookToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_SLACK:                    {"sysdig_monitor_notification_channel_slack", resourceSysdigMonitorNotificationChannelSlack, monitorNotificationChannelSlackToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_PAGERDUTY:                {"sysdig_monitor_notification_channel_pagerduty", resourceSysdigMonitorNotificationChannelPagerduty, notificationChannelPagerdutyToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS:                 {"sysdig_monitor_notification_channel_msteams", resourceSysdigMonitorNotificationChannelMSTeams, notificationChannelMSTeamsToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_GCHAT:                    {"sysdig_monitor_notification_channel_google_chat", resourceSysdigMonitorNotificationChannelGoogleChat, notificationChannelGoogleChatToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER: {"sysdig_monitor_notification_channel_prometheus_alert_manager", resourceSysdigMonitorNotificationChannelPrometheusAlertManager, notificationChannelPrometheusAlertManagerToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_TEAM_EMAIL:               {"sysdig_monitor_notification_channel_team_email", resourceSysdigMonitorNotificationChannelTeamEmail, notificationChannelTeamEmailToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK:           {"sysdig_monitor_notification_channel_custom_webhook", resourceSysdigMonitorNotificationChannelCustomWebhook, notificationChannelCustomWebhookToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_IBM_EVENT_NOTIFICATION:   {"sysdig_monitor_notification_channel_ibm_event_notification", resourceSysdigMonitorNotificationChannelIBMEventNotification, notificationChannelIBMEventNotificationToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION:             {"sysdig_monitor_notification_channel_ibm_function", resourceSysdigMonitorNotificationChannelIBMFunction, notificationChannelIBMFunctionToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_SERVICENOW:               {"sysdig_monitor_notification_channel_servicenow", resourceSysdigMonitorNotificationChannelServiceNow, notificationChannelServiceNowToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_JIRA:                     {"sysdig_monitor_notification_channel_jira", resourceSysdigMonitorNotificationChannelJira, notificationChannelJiraToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_WEBEX:                    {"sysdig_monitor_notification_channel_webex", resourceSysdigMonitorNotificationChannelWebex, notificationChannelWebexToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_ZENDUTY:                  {"sysdig_monitor_notification_channel_zenduty", resourceSysdigMonitorNotificationChannelZenduty, notificationChannelZendutyToResourceData},
+}
+
+var secureNotificationChannelResourceTypes = map[string]notificationChannelResourceType{
+	NOTIFICATION_CHANNEL_TYPE_EMAIL:                    {"sysdig_secure_notification_channel_email", resourceSysdigSecureNotificationChannelEmail, notificationChannelEmailToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_AMAZON_SNS:               {"sysdig_secure_notification_channel_sns", resourceSysdigSecureNotificationChannelSNS, notificationChannelSNSToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_OPSGENIE:                 {"sysdig_secure_notification_channel_opsgenie", resourceSysdigSecureNotificationChannelOpsGenie, notificationChannelOpsGenieToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_VICTOROPS:                {"sysdig_secure_notification_channel_victorops", resourceSysdigSecureNotificationChannelVictorOps, notificationChannelVictorOpsToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_WEBHOOK:                  {"sysdig_secure_notification_channel_webhook", resourceSysdigSecureNotificationChannelWebhook, notificationChannelWebhookToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_SLACK:                    {"sysdig_secure_notification_channel_slack", resourceSysdigSecureNotificationChannelSlack, secureNotificationChannelSlackToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_PAGERDUTY:                {"sysdig_secure_notification_channel_pagerduty", resourceSysdigSecureNotificationChannelPagerduty, notificationChannelPagerdutyToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS:                 {"sysdig_secure_notification_channel_msteams", resourceSysdigSecureNotificationChannelMSTeams, secureNotificationChannelMSTeamsToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_GCHAT:                    {"sysdig_secure_notification_channel_google_chat", resourceSysdigSecureNotificationChannelGoogleChat, notificationChannelGoogleChatToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER: {"sysdig_secure_notification_channel_prometheus_alert_manager", resourceSysdigSecureNotificationChannelPrometheusAlertManager, notificationChannelPrometheusAlertManagerToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_TEAM_EMAIL:               {"sysdig_secure_notification_channel_team_email", resourceSysdigSecureNotificationChannelTeamEmail, notificationChannelTeamEmailToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK:           {"sysdig_secure_notification_channel_custom_webhook", resourceSysdigSecureNotificationChannelCustomWebhook, notificationChannelCustomWebhookToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_IBM_EVENT_NOTIFICATION:   {"sysdig_secure_notification_channel_ibm_event_notification", resourceSysdigSecureNotificationChannelIBMEventNotification, notificationChannelIBMEventNotificationToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION:             {"sysdig_secure_notification_channel_ibm_function", resourceSysdigSecureNotificationChannelIBMFunction, notificationChannelIBMFunctionToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_SERVICENOW:               {"sysdig_secure_notification_channel_servicenow", resourceSysdigSecureNotificationChannelServiceNow, notificationChannelServiceNowToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_JIRA:                     {"sysdig_secure_notification_channel_jira", resourceSysdigSecureNotificationChannelJira, notificationChannelJiraToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_WEBEX:                    {"sysdig_secure_notification_channel_webex", resourceSysdigSecureNotificationChannelWebex, notificationChannelWebexToResourceData},
+	NOTIFICATION_CHANNEL_TYPE_ZENDUTY:                  {"sysdig_secure_notification_channel_zenduty", resourceSysdigSecureNotificationChannelZenduty, notificationChannelZendutyToResourceData},
+}
+
+var notificationChannelHCLLabelInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// notificationChannelHCL returns the import block and the resource block managing the channel. The secrets are not
+// returned by Sysdig, they are left as comments to be set in the configuration.
+func notificationChannelHCL(nc *v2.NotificationChannel, resourceType notificationChannelResourceType) (string, error) {
+	resource := resourceType.resource()
+	d := resource.Data(nil)
+	err := resourceType.toResourceData(nc, d)
+	if err != nil {
+		return "", err
+	}
+
+	label := strings.Trim(notificationChannelHCLLabelInvalidChars.ReplaceAllString(strings.ToLower(nc.Name), "_"), "_")
+	label = fmt.Sprintf("%s_%d", label, nc.ID)
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "channel_" + label
+	}
+
+	secrets := map[string]bool{}
+	for _, secret := range notificationChannelSecrets[nc.Type] {
+		secrets[secret] = true
+	}
+
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "import {\n  to = %s.%s\n  id = %q\n}\n\n", resourceType.name, label, strconv.Itoa(nc.ID))
+	fmt.Fprintf(builder, "resource %q %q {\n", resourceType.name, label)
+	keys := append([]string{"name"}, sortedSchemaKeys(resource.Schema)...)
+	for i, key := range keys {
+		if i > 0 && key == "name" {
+			continue
+		}
+		if secrets[key] && resource.Schema[key].Type == schema.TypeMap {
+			writeNotificationChannelHCLHeaders(builder, key, d.Get(key).(map[string]interface{}))
+			continue
+		}
+		if secrets[key] {
+			fmt.Fprintf(builder, "  # %s = \"\" # not returned by Sysdig\n", key)
+			continue
+		}
+		writeNotificationChannelHCLAttribute(builder, "  ", key, resource.Schema[key], d.Get(key))
+	}
+	builder.WriteString("}\n")
+
+	return builder.String(), nil
+}
+
+// writeNotificationChannelHCLHeaders writes the headers read back from Sysdig, the authentication ones being left as
+// comments
+func writeNotificationChannelHCLHeaders(builder *strings.Builder, key string, headers map[string]interface{}) {
+	if len(headers) == 0 {
+		fmt.Fprintf(builder, "  # %s = {} # the authentication headers are not returned by Sysdig\n", key)
+		return
+	}
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(builder, "  %s = {\n", key)
+	for _, k := range keys {
+		if isNotificationChannelSecretHeader(k) {
+			fmt.Fprintf(builder, "    # %s = \"\" # not returned by Sysdig\n", notificationChannelHCLValue(k))
+			continue
+		}
+		fmt.Fprintf(builder, "    %s = %s\n", notificationChannelHCLValue(k), notificationChannelHCLValue(headers[k]))
+	}
+	builder.WriteString("  }\n")
+}
+
+// writeNotificationChannelHCLAttribute writes the configurable attributes which are not set to their default, the
+// computed ones being read back from Sysdig
+func writeNotificationChannelHCLAttribute(builder *strings.Builder, indent, key string, s *schema.Schema, value interface{}) {
+	if !s.Optional && !s.Required || s.Computed || key == "secret_version" {
+		return
+	}
+	if s.Default != nil && value == s.Default {
+		return
+	}
+
+	switch s.Type {
+	case schema.TypeString, schema.TypeBool, schema.TypeInt:
+		if s.Default == nil && s.Optional && (value == "" || value == false || value == 0) {
+			return
+		}
+		fmt.Fprintf(builder, "%s%s = %s\n", indent, key, notificationChannelHCLValue(value))
+	case schema.TypeMap:
+		values := value.(map[string]interface{})
+		if len(values) == 0 {
+			return
+		}
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(builder, "%s%s = {\n", indent, key)
+		for _, k := range keys {
+			fmt.Fprintf(builder, "%s  %s = %s\n", indent, notificationChannelHCLValue(k), notificationChannelHCLValue(values[k]))
+		}
+		fmt.Fprintf(builder, "%s}\n", indent)
+	case schema.TypeList, schema.TypeSet:
+		var values []interface{}
+		if set, ok := value.(*schema.Set); ok {
+			values = set.List()
+		} else {
+			values = value.([]interface{})
+		}
+		if len(values) == 0 {
+			return
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, v := range values {
+				block := v.(map[string]interface{})
+				fmt.Fprintf(builder, "%s%s {\n", indent, key)
+				for _, k := range sortedSchemaKeys(elem.Schema) {
+					writeNotificationChannelHCLAttribute(builder, indent+"  ", k, elem.Schema[k], block[k])
+				}
+				fmt.Fprintf(builder, "%s}\n", indent)
+			}
+			return
+		}
+		items := make([]string, 0, len(values))
+		for _, v := range values {
+			items = append(items, notificationChannelHCLValue(v))
+		}
+		fmt.Fprintf(builder, "%s%s = [%s]\n", indent, key, strings.Join(items, ", "))
+	}
+}
+
+func notificationChannelHCLValue(value interface{}) string {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", "$${", "%{", "%%{").Replace(s)
+	return `"` + s + `"`
+}
+
+func sortedSchemaKeys(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
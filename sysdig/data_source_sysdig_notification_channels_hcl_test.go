@@ -0,0 +1,74 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestNotificationChannelHCL(t *testing.T) {
+	teamID := 5
+	slack := v2.NotificationChannel{
+		ID:      1,
+		Name:    "Alerts ${env}",
+		Type:    NOTIFICATION_CHANNEL_TYPE_SLACK,
+		Enabled: true,
+		TeamID:  &teamID,
+		Options: v2.NotificationChannelOptions{
+			Url:        "https://hooks.slack.com/services/XXX",
+			Channel:    "#alerts",
+			NotifyOnOk: true,
+			TemplateConfiguration: []v2.NotificationChannelTemplateConfiguration{
+				{TemplateKey: NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V2},
+			},
+		},
+	}
+
+	hcl, err := notificationChannelHCL(&slack, secureNotificationChannelResourceTypes[slack.Type])
+	assert.NoError(t, err)
+	assert.Equal(t, `import {
+  to = sysdig_secure_notification_channel_slack.alerts_env_1
+  id = "1"
+}
+
+resource "sysdig_secure_notification_channel_slack" "alerts_env_1" {
+  name = "Alerts $${env}"
+  channel = "#alerts"
+  notify_when_ok = true
+  share_with_current_team = true
+  template_version = "v2"
+  url = "https://hooks.slack.com/services/XXX"
+}
+`, hcl)
+
+	pagerduty := v2.NotificationChannel{
+		ID:   2,
+		Name: "2nd line",
+		Type: NOTIFICATION_CHANNEL_TYPE_PAGERDUTY,
+		Options: v2.NotificationChannelOptions{
+			Account:     "account",
+			ServiceKey:  "masked",
+			ServiceName: "sysdig",
+		},
+	}
+
+	hcl, err = notificationChannelHCL(&pagerduty, monitorNotificationChannelResourceTypes[pagerduty.Type])
+	assert.NoError(t, err)
+	assert.Equal(t, `import {
+  to = sysdig_monitor_notification_channel_pagerduty.channel_2nd_line_2
+  id = "2"
+}
+
+resource "sysdig_monitor_notification_channel_pagerduty" "channel_2nd_line_2" {
+  name = "2nd line"
+  account = "account"
+  enabled = false
+  # service_key = "" # not returned by Sysdig
+  service_name = "sysdig"
+}
+`, hcl)
+}
@@ -26,12 +26,19 @@ const (
 	NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK           = "POWER_WEBHOOK"
 	NOTIFICATION_CHANNEL_TYPE_IBM_EVENT_NOTIFICATION   = "IBM_EVENT_NOTIFICATIONS"
 	NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION             = "IBM_FUNCTION"
+	NOTIFICATION_CHANNEL_TYPE_SERVICENOW               = "SERVICENOW"
+	NOTIFICATION_CHANNEL_TYPE_JIRA                     = "JIRA"
+	NOTIFICATION_CHANNEL_TYPE_WEBEX                    = "WEBEX"
+	NOTIFICATION_CHANNEL_TYPE_ZENDUTY                  = "ZENDUTY"
 
 	NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V1    = "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v1"
 	NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V2    = "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"
 	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V1 = "MS_TEAMS_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v1"
 	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V2 = "MS_TEAMS_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"
 
+	NOTIFICATION_CHANNEL_TYPE_SLACK_MONITOR_TEMPLATE_KEY_V1    = "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_MONITOR_TEMPLATE_KEY_V1 = "MS_TEAMS_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"
+
 	NOTIFICATION_CHANNEL_SECURE_EVENT_NOTIFICATION_CONTENT_SECTION = "SECURE_EVENT_NOTIFICATION_CONTENT"
 )
 
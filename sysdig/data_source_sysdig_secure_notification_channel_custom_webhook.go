@@ -0,0 +1,66 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSysdigSecureNotificationChannelCustomWebhook() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigSecureNotificationChannelCustomWebhookRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allow_insecure_connections": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"additional_headers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceSysdigSecureNotificationChannelCustomWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getSecureNotificationChannelClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nc, err := client.GetNotificationChannelByName(ctx, d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = notificationChannelCustomWebhookToResourceData(&nc, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(nc.ID))
+
+	return nil
+}
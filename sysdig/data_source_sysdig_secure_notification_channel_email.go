@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelEmail() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"recipients": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -40,7 +40,7 @@ func dataSourceSysdigSecureNotificationChannelEmailRead(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelEmailToResourceData(&nc, d)
+	err = notificationChannelEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelMSTeams() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -28,6 +28,7 @@ func dataSourceSysdigSecureNotificationChannelMSTeams() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationDataSourceSchema(),
 		}),
 	}
 }
@@ -47,6 +48,8 @@ func dataSourceSysdigSecureNotificationChannelMSTeamsRead(ctx context.Context, d
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// all the sections are listed, there is no configuration to only keep the ones it lists
+	_ = d.Set("template_configuration", flattenNotificationChannelTemplateConfiguration(nc.Options.TemplateConfiguration, nil))
 
 	d.SetId(strconv.Itoa(nc.ID))
 
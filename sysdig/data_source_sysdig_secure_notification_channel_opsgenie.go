@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelOpsGenie() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"api_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -43,7 +43,7 @@ func dataSourceSysdigSecureNotificationChannelOpsGenieRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelOpsGenieToResourceData(&nc, d)
+	err = notificationChannelOpsGenieToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelPagerduty() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"account": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +47,7 @@ func dataSourceSysdigSecureNotificationChannelPagerdutyRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelPagerdutyToResourceData(&nc, d)
+	err = notificationChannelPagerdutyToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
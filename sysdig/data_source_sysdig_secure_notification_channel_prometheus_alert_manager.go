@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelPrometheusAlertManager() *schema.R
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +47,7 @@ func dataSourceSysdigSecureNotificationChannelPrometheusAlertManagerRead(ctx con
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelPrometheusAlertManagerToResourceData(&nc, d)
+	err = notificationChannelPrometheusAlertManagerToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
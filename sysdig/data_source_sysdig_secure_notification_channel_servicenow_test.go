@@ -0,0 +1,53 @@
+//go:build tf_acc_sysdig_secure || tf_acc_ibm_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelServiceNowDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelServiceNow(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "id", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "id"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "name", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "name"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "url", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "url"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channel_servicenow.nc_servicenow", "username", "sysdig_secure_notification_channel_servicenow.nc_servicenow", "username"),
+				),
+			},
+		},
+	})
+}
+
+func secureNotificationChannelServiceNow(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_servicenow" "nc_servicenow" {
+	name = "Example Channel %s - ServiceNow"
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+}
+
+data "sysdig_secure_notification_channel_servicenow" "nc_servicenow" {
+	name = sysdig_secure_notification_channel_servicenow.nc_servicenow.name
+}
+`, name)
+}
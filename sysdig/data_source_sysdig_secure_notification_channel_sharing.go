@@ -0,0 +1,21 @@
+package sysdig
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSysdigSecureNotificationChannelSharing() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: getDataSourceSysdigNotificationChannelSharingRead(getSecureNotificationChannelClient),
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: dataSourceSysdigNotificationChannelSharingSchema(),
+	}
+}
@@ -0,0 +1,97 @@
+//go:build tf_acc_sysdig_secure || tf_acc_ibm_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelSharingDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelSharing(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channel_sharing.global", "shared_with_all_teams", "true"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channel_sharing.global", "team_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channel_sharing.teams", "shared_with_all_teams", "false"),
+					resource.TestCheckTypeSetElemAttrPair("data.sysdig_secure_notification_channel_sharing.teams", "team_ids.*", "sysdig_secure_team.sample", "id"),
+					resource.TestCheckTypeSetElemAttrPair("sysdig_secure_notification_channel_email.teams", "shared_with_teams.*", "sysdig_secure_team.sample", "id"),
+				),
+			},
+			{
+				Config: secureNotificationChannelSharedGlobally(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_email.teams", "shared_globally", "true"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_email.teams", "shared_with_teams.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_email.teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func secureNotificationChannelSharing(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_team" "sample" {
+	name = "sample-%s"
+}
+
+resource "sysdig_secure_notification_channel_email" "global" {
+	name = "%s - global"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_secure_notification_channel_email" "teams" {
+	name = "%s - teams"
+	recipients = ["root@localhost.com"]
+	shared_with_teams = [sysdig_secure_team.sample.id]
+}
+
+data "sysdig_secure_notification_channel_sharing" "global" {
+	notification_channel_id = sysdig_secure_notification_channel_email.global.id
+}
+
+data "sysdig_secure_notification_channel_sharing" "teams" {
+	notification_channel_id = sysdig_secure_notification_channel_email.teams.id
+}
+`, name, name, name)
+}
+
+func secureNotificationChannelSharedGlobally(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_team" "sample" {
+	name = "sample-%s"
+}
+
+resource "sysdig_secure_notification_channel_email" "global" {
+	name = "%s - global"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_secure_notification_channel_email" "teams" {
+	name = "%s - teams"
+	recipients = ["root@localhost.com"]
+	shared_globally = true
+}
+`, name, name, name)
+}
@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelSNS() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"topics": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -40,7 +40,7 @@ func dataSourceSysdigSecureNotificationChannelSNSRead(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelSNSToResourceData(&nc, d)
+	err = notificationChannelSNSToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelTeamEmail() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -39,7 +39,7 @@ func dataSourceSysdigSecureNotificationChannelTeamEmailRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelTeamEmailToResourceData(&nc, d)
+	err = notificationChannelTeamEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,7 +19,7 @@ func dataSourceSysdigSecureNotificationChannelWebhook() *schema.Resource {
 			Read: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,7 +51,7 @@ func dataSourceSysdigSecureNotificationChannelWebhookRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelWebhookToResourceData(&nc, d)
+	err = notificationChannelWebhookToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
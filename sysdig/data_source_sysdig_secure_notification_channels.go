@@ -0,0 +1,21 @@
+package sysdig
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSysdigSecureNotificationChannels() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: getDataSourceSysdigNotificationChannelsRead(getSecureNotificationChannelClient, secureNotificationChannelResourceTypes),
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: dataSourceSysdigNotificationChannelsSchema(),
+	}
+}
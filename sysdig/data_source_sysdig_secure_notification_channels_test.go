@@ -0,0 +1,81 @@
+//go:build tf_acc_sysdig_secure || tf_acc_ibm_secure
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelsDataSource(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannels(rText),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sysdig_secure_notification_channels.pager", "ids.0", "sysdig_secure_notification_channel_pagerduty.pager", "id"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "notification_channels.0.type", "PAGER_DUTY"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "notification_channels.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "notification_channels.0.resource_type", "sysdig_secure_notification_channel_pagerduty"),
+					resource.TestCheckResourceAttrSet("data.sysdig_secure_notification_channels.pager", "notification_channels.0.hcl"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "notification_channels.0.shared_globally", "true"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.pager", "notification_channels.0.shared_with_teams.#", "0"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.disabled", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.sysdig_secure_notification_channels.not_global", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func secureNotificationChannels(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_email" "email" {
+	name = "%s - email"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_secure_notification_channel_pagerduty" "pager" {
+	name = "%s - pager"
+	account = "account"
+	service_key = "XXXXXXXXXX"
+	service_name = "sysdig"
+}
+
+data "sysdig_secure_notification_channels" "pager" {
+	name_regex = "^%s - "
+	types = ["PAGER_DUTY", "OPSGENIE", "VICTOROPS"]
+
+	depends_on = [sysdig_secure_notification_channel_email.email, sysdig_secure_notification_channel_pagerduty.pager]
+}
+
+data "sysdig_secure_notification_channels" "not_global" {
+	name_regex = "^%s - "
+	shared_globally = false
+
+	depends_on = [sysdig_secure_notification_channel_email.email, sysdig_secure_notification_channel_pagerduty.pager]
+}
+
+data "sysdig_secure_notification_channels" "disabled" {
+	name_regex = "^%s - "
+	enabled = false
+
+	depends_on = [sysdig_secure_notification_channel_email.email, sysdig_secure_notification_channel_pagerduty.pager]
+}
+`, name, name, name, name, name)
+}
@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -13,17 +14,21 @@ import (
 var AlertV2NotFound = errors.New("alert not found")
 
 type (
-	AlertV2Type     string
-	AlertV2Severity string
-	AlertLinkV2Type string
+	AlertV2Type            string
+	AlertV2Severity        string
+	AlertLinkV2Type        string
+	AlertV2NoDataBehaviour string
 )
 
 const (
 	alertsV2Path            = "%s/api/v2/alerts"
+	alertsV2PagePath        = "%s/api/v2/alerts?offset=%d&limit=%d"
 	alertV2Path             = "%s/api/v2/alerts/%d"
 	labelsV3Path            = "%s/api/v3/labels/?limit=6000"
 	labelsV3DescriptorsPath = "%s/api/v3/labels/descriptors/%s"
 
+	alertsV2PageSize = 100
+
 	AlertV2TypePrometheus          AlertV2Type = "PROMETHEUS"
 	AlertV2TypeManual              AlertV2Type = "MANUAL"
 	AlertV2TypeEvent               AlertV2Type = "EVENT"
@@ -37,6 +42,10 @@ const (
 
 	AlertLinkV2TypeDashboard AlertLinkV2Type = "dashboard"
 	AlertLinkV2TypeRunbook   AlertLinkV2Type = "runbook"
+
+	AlertV2NoDataBehaviourDoNothing AlertV2NoDataBehaviour = "DO_NOTHING"
+	AlertV2NoDataBehaviourTrigger   AlertV2NoDataBehaviour = "TRIGGER"
+	AlertV2NoDataBehaviourResolve   AlertV2NoDataBehaviour = "RESOLVE"
 )
 
 var labelCache struct {
@@ -52,6 +61,7 @@ type AlertV2Interface interface {
 	AlertV2DowntimeInterface
 	AlertV2ChangeInterface
 	AlertV2FormBasedPrometheusInterface
+	AlertV2NotificationRoutingInterface
 }
 
 type AlertV2PrometheusInterface interface {
@@ -102,6 +112,13 @@ type AlertV2DowntimeInterface interface {
 	DeleteAlertV2Downtime(ctx context.Context, alertID int) error
 }
 
+// AlertV2NotificationRoutingInterface handles the notification channels of the alerts regardless of their type
+type AlertV2NotificationRoutingInterface interface {
+	Base
+	ListAlertsV2(ctx context.Context) ([]AlertV2Common, error)
+	UpdateAlertV2NotificationChannels(ctx context.Context, alertID int, notificationChannelConfigList []NotificationChannelConfigV2) error
+}
+
 func (client *Client) CreateAlertV2Prometheus(ctx context.Context, alert AlertV2Prometheus) (AlertV2Prometheus, error) {
 	err := client.addNotificationChannelType(ctx, alert.NotificationChannelConfigList)
 	if err != nil {
@@ -547,6 +564,106 @@ func (client *Client) DeleteAlertV2FormBasedPrometheus(ctx context.Context, aler
 	return client.deleteAlertV2(ctx, alertID)
 }
 
+// ListAlertsV2 fetches all the alerts of the current team page by page, until a page is not full.
+// A backend ignoring the paging parameters returns all the alerts at once, which also ends the listing.
+func (client *Client) ListAlertsV2(ctx context.Context) ([]AlertV2Common, error) {
+	var alerts []AlertV2Common
+	seen := map[int]bool{}
+	for offset := 0; ; offset += alertsV2PageSize {
+		page, err := client.listAlertsV2Page(ctx, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, alert := range page {
+			if !seen[alert.ID] {
+				seen[alert.ID] = true
+				alerts = append(alerts, alert)
+				added++
+			}
+		}
+		if len(page) < alertsV2PageSize || added == 0 {
+			return alerts, nil
+		}
+	}
+}
+
+func (client *Client) listAlertsV2Page(ctx context.Context, offset int) ([]AlertV2Common, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.alertsV2PageURL(offset), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[alertV2ListWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Alerts, nil
+}
+
+// UpdateAlertV2NotificationChannels replaces the notification channels of an alert of any type, the rest of its
+// configuration being sent back as it is returned by the API
+func (client *Client) UpdateAlertV2NotificationChannels(ctx context.Context, alertID int, notificationChannelConfigList []NotificationChannelConfigV2) error {
+	err := client.addNotificationChannelType(ctx, notificationChannelConfigList)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.requester.Request(ctx, http.MethodGet, client.alertV2URL(alertID), nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return AlertV2NotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[map[string]map[string]json.RawMessage](response.Body)
+	if err != nil {
+		return err
+	}
+	alert, ok := wrapper["alert"]
+	if !ok {
+		return fmt.Errorf("alert %d not found in the response", alertID)
+	}
+
+	if notificationChannelConfigList == nil {
+		notificationChannelConfigList = []NotificationChannelConfigV2{}
+	}
+	alert["notificationChannelConfigList"], err = json.Marshal(notificationChannelConfigList)
+	if err != nil {
+		return err
+	}
+
+	payload, err := Marshal(wrapper)
+	if err != nil {
+		return err
+	}
+
+	response, err = client.requester.Request(ctx, http.MethodPut, client.alertV2URL(alertID), payload)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return client.ErrorFromResponse(response)
+	}
+
+	return nil
+}
+
 func (client *Client) createAlertV2(ctx context.Context, alertJson io.Reader) (io.ReadCloser, error) {
 	response, err := client.requester.Request(ctx, http.MethodPost, client.alertsV2URL(), alertJson)
 	if err != nil {
@@ -715,6 +832,10 @@ func (client *Client) alertsV2URL() string {
 	return fmt.Sprintf(alertsV2Path, client.config.url)
 }
 
+func (client *Client) alertsV2PageURL(offset int) string {
+	return fmt.Sprintf(alertsV2PagePath, client.config.url, offset, alertsV2PageSize)
+}
+
 func (client *Client) alertV2URL(alertID int) string {
 	return fmt.Sprintf(alertV2Path, client.config.url, alertID)
 }
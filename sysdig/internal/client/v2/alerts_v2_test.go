@@ -0,0 +1,88 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListAlertsV2(t *testing.T) {
+	total := alertsV2PageSize + 5
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, limit := 0, 0
+		_, _ = fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		_, _ = fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		var page []string
+		for id := offset + 1; id <= total && id <= offset+limit; id++ {
+			page = append(page, fmt.Sprintf(`{"id": %d, "name": "alert-%d", "type": "PROMETHEUS"}`, id, id))
+		}
+		_, _ = fmt.Fprintf(w, `{"alerts": [%s]}`, strings.Join(page, ","))
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+
+	alerts, err := client.ListAlertsV2(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != total || alerts[total-1].ID != total {
+		t.Errorf("expected %d alerts, got %d", total, len(alerts))
+	}
+	if strings.Join(offsets, ",") != fmt.Sprintf("0,%d", alertsV2PageSize) {
+		t.Errorf("expected two pages to be requested, got the offsets %v", offsets)
+	}
+}
+
+func TestUpdateAlertV2NotificationChannels(t *testing.T) {
+	var updated map[string]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/notificationChannels/5":
+			_, _ = fmt.Fprint(w, `{"notificationChannel": {"id": 5, "name": "pager", "type": "PAGER_DUTY"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/alerts/1":
+			_, _ = fmt.Fprint(w, `{"alert": {"id": 1, "version": 3, "type": "PROMETHEUS", "config": {"query": "up == 0"}, "notificationChannelConfigList": [{"channelId": 4}]}}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v2/alerts/1":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &updated); err != nil {
+				t.Errorf("unexpected payload %s: %v", body, err)
+			}
+			_, _ = w.Write(body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := context.Background()
+
+	err := client.UpdateAlertV2NotificationChannels(ctx, 1, []NotificationChannelConfigV2{{ChannelID: 5}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alert := updated["alert"]
+	if alert["version"] != float64(3) || alert["config"].(map[string]interface{})["query"] != "up == 0" {
+		t.Errorf("expected the alert configuration to be kept, got %v", alert)
+	}
+	channels := alert["notificationChannelConfigList"].([]interface{})
+	if len(channels) != 1 || channels[0].(map[string]interface{})["channelId"] != float64(5) || channels[0].(map[string]interface{})["type"] != "PAGER_DUTY" {
+		t.Errorf("expected the notification channel 5 of type PAGER_DUTY, got %v", channels)
+	}
+
+	err = client.UpdateAlertV2NotificationChannels(ctx, 2, nil)
+	if err != AlertV2NotFound {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -50,6 +50,7 @@ type MonitorCommon interface {
 	AlertV2Interface
 	DashboardInterface
 	SilenceRuleInterface
+	InhibitionRuleInterface
 }
 
 type SecureCommon interface {
@@ -65,6 +66,8 @@ type Requester interface {
 type Client struct {
 	config    *config
 	requester Requester
+
+	notificationChannels notificationChannelCache
 }
 
 func (client *Client) ErrorFromResponse(response *http.Response) error {
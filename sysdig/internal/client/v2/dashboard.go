@@ -2,20 +2,54 @@ package v2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+)
+
+var (
+	DashboardNotFound         = errors.New("dashboard not found")
+	DashboardTemplateNotFound = errors.New("dashboard template not found")
 )
 
 const (
-	dashboardsPath = "%s/api/v3/dashboards"
-	dashboardPath  = "%s/api/v3/dashboards/%d"
+	dashboardsPath        = "%s/api/v3/dashboards"
+	dashboardsLightPath   = "%s/api/v3/dashboards?light=true"
+	dashboardPath         = "%s/api/v3/dashboards/%d"
+	dashboardTemplatePath = "%s/api/v3/dashboards/templates/%s"
 )
 
 type DashboardInterface interface {
+	ListDashboards(ctx context.Context) ([]*Dashboard, error)
 	GetDashboard(ctx context.Context, ID int) (*Dashboard, error)
 	CreateDashboard(ctx context.Context, dashboard *Dashboard) (*Dashboard, error)
 	UpdateDashboard(ctx context.Context, dashboard *Dashboard) (*Dashboard, error)
 	DeleteDashboard(ctx context.Context, ID int) error
+	GetDashboardJSON(ctx context.Context, ID int) (map[string]interface{}, error)
+	CreateDashboardJSON(ctx context.Context, dashboard map[string]interface{}) (map[string]interface{}, error)
+	UpdateDashboardJSON(ctx context.Context, ID int, dashboard map[string]interface{}) (map[string]interface{}, error)
+	GetDashboardTemplate(ctx context.Context, ID string) (*DashboardTemplate, error)
+}
+
+// ListDashboards returns all the dashboards visible to the current user, without their panels
+func (client *Client) ListDashboards(ctx context.Context) ([]*Dashboard, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.getDashboardsLightURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardListWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboards, nil
 }
 
 func (client *Client) GetDashboard(ctx context.Context, ID int) (*Dashboard, error) {
@@ -25,6 +59,9 @@ func (client *Client) GetDashboard(ctx context.Context, ID int) (*Dashboard, err
 	}
 	defer response.Body.Close()
 
+	if response.StatusCode == http.StatusNotFound {
+		return nil, DashboardNotFound
+	}
 	if response.StatusCode != http.StatusOK {
 		return nil, client.ErrorFromResponse(response)
 	}
@@ -99,10 +136,112 @@ func (client *Client) DeleteDashboard(ctx context.Context, ID int) error {
 	return nil
 }
 
+// GetDashboardJSON returns the dashboard as sent by the API, keeping the fields not mapped in Dashboard
+func (client *Client) GetDashboardJSON(ctx context.Context, ID int) (map[string]interface{}, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.getDashboardURL(ID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, DashboardNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardJSONWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboard, nil
+}
+
+func (client *Client) CreateDashboardJSON(ctx context.Context, dashboard map[string]interface{}) (map[string]interface{}, error) {
+	payload, err := Marshal(dashboardJSONWrapper{Dashboard: dashboard})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.requester.Request(ctx, http.MethodPost, client.getDashboardsURL(), payload)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardJSONWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboard, nil
+}
+
+func (client *Client) UpdateDashboardJSON(ctx context.Context, ID int, dashboard map[string]interface{}) (map[string]interface{}, error) {
+	payload, err := Marshal(dashboardJSONWrapper{Dashboard: dashboard})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.requester.Request(ctx, http.MethodPut, client.getDashboardURL(ID), payload)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardJSONWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.Dashboard, nil
+}
+
+// GetDashboardTemplate returns the latest version of a dashboard of the Sysdig dashboard library
+func (client *Client) GetDashboardTemplate(ctx context.Context, ID string) (*DashboardTemplate, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.getDashboardTemplateURL(ID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, DashboardTemplateNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[dashboardTemplateWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.DashboardTemplate, nil
+}
+
 func (client *Client) getDashboardsURL() string {
 	return fmt.Sprintf(dashboardsPath, client.config.url)
 }
 
+func (client *Client) getDashboardsLightURL() string {
+	return fmt.Sprintf(dashboardsLightPath, client.config.url)
+}
+
 func (client *Client) getDashboardURL(id int) string {
 	return fmt.Sprintf(dashboardPath, client.config.url, id)
 }
+
+func (client *Client) getDashboardTemplateURL(id string) string {
+	return fmt.Sprintf(dashboardTemplatePath, client.config.url, url.PathEscape(id))
+}
@@ -112,8 +112,8 @@ type NotificationChannelOptions struct {
 	EmailRecipients          []string                                   `json:"emailRecipients,omitempty"`          // Type: email
 	SnsTopicARNs             []string                                   `json:"snsTopicARNs,omitempty"`             // Type: SNS
 	APIKey                   string                                     `json:"apiKey,omitempty"`                   // Type: VictorOps, ibm event function
-	RoutingKey               string                                     `json:"routingKey,omitempty"`               // Type: VictorOps
-	Url                      string                                     `json:"url,omitempty"`                      // Type: OpsGenie, Webhook, Slack, google chat, prometheus alert manager, custom webhook, ms teams
+	RoutingKey               string                                     `json:"routingKey,omitempty"`               // Type: VictorOps, zenduty
+	Url                      string                                     `json:"url,omitempty"`                      // Type: OpsGenie, Webhook, Slack, google chat, prometheus alert manager, custom webhook, ms teams, servicenow, jira
 	Channel                  string                                     `json:"channel,omitempty"`                  // Type: Slack
 	Account                  string                                     `json:"account,omitempty"`                  // Type: PagerDuty
 	ServiceKey               string                                     `json:"serviceKey,omitempty"`               // Type: PagerDuty
@@ -128,6 +128,13 @@ type NotificationChannelOptions struct {
 	IbmFunctionType          string                                     `json:"ibmFunctionType,omitempty"`          // Type: ibm event function
 	CustomData               map[string]interface{}                     `json:"customData,omitempty"`               // Type: ibm function, Webhook
 	TemplateConfiguration    []NotificationChannelTemplateConfiguration `json:"templateConfiguration,omitempty"`    // Type: slack, ms teams
+	Username                 string                                     `json:"username,omitempty"`                 // Type: servicenow, jira
+	Password                 string                                     `json:"password,omitempty"`                 // Type: servicenow
+	APIToken                 string                                     `json:"apiToken,omitempty"`                 // Type: jira
+	Project                  string                                     `json:"project,omitempty"`                  // Type: jira
+	IssueType                string                                     `json:"issueType,omitempty"`                // Type: jira
+	RoomId                   string                                     `json:"roomId,omitempty"`                   // Type: webex
+	BotToken                 string                                     `json:"botToken,omitempty"`                 // Type: webex
 
 	NotifyOnOk           bool `json:"notifyOnOk"`
 	NotifyOnResolve      bool `json:"notifyOnResolve"`
@@ -141,9 +148,15 @@ type NotificationChannel struct {
 	Name    string                     `json:"name"`
 	Enabled bool                       `json:"enabled"`
 	TeamID  *int                       `json:"teamId,omitempty"`
+	TeamIDs *[]int                     `json:"teamIds,omitempty"`
 	Options NotificationChannelOptions `json:"options"`
 }
 
+type NotificationChannelTestResult struct {
+	Success bool
+	Message string
+}
+
 type notificationChannelListWrapper struct {
 	NotificationChannels []NotificationChannel `json:"notificationChannels"`
 }
@@ -518,17 +531,24 @@ type AlertV2Common struct {
 	CustomNotificationTemplate    *CustomNotificationTemplateV2 `json:"customNotificationTemplate,omitempty"`
 	CaptureConfig                 *CaptureConfigV2              `json:"captureConfig,omitempty"`
 	Links                         []AlertLinkV2                 `json:"links"`
+	Labels                        map[string]string             `json:"labels,omitempty"`
+}
+
+type alertV2ListWrapper struct {
+	Alerts []AlertV2Common `json:"alerts"`
 }
 
 type AlertV2ConfigPrometheus struct {
 	Query            string `json:"query"`
 	KeepFiringForSec *int   `json:"keepFiringForSec,omitempty"`
+	NoDataBehaviour  string `json:"noDataBehaviour,omitempty"`
 }
 
 type AlertV2Prometheus struct {
 	AlertV2Common
-	DurationSec int                     `json:"durationSec"`
-	Config      AlertV2ConfigPrometheus `json:"config"`
+	DurationSec                              int                     `json:"durationSec"`
+	Config                                   AlertV2ConfigPrometheus `json:"config"`
+	UnreportedAlertNotificationsRetentionSec *int                    `json:"unreportedAlertNotificationsRetentionSec,omitempty"`
 }
 
 type alertV2PrometheusWrapper struct {
@@ -655,8 +675,9 @@ type AlertV2ConfigChange struct {
 	TimeAggregation  string                  `json:"timeAggregation"`
 	Metric           AlertMetricDescriptorV2 `json:"metric"`
 
-	ShorterRangeSec int `json:"shorterRangeSec"`
-	LongerRangeSec  int `json:"longerRangeSec"`
+	ShorterRangeSec int    `json:"shorterRangeSec"`
+	LongerRangeSec  int    `json:"longerRangeSec"`
+	NoDataBehaviour string `json:"noDataBehaviour,omitempty"`
 }
 
 type AlertV2ConfigFormBasedPrometheus struct {
@@ -796,6 +817,24 @@ type SilenceRule struct {
 	ID      int `json:"id,omitempty"`
 }
 
+type InhibitionRule struct {
+	Name           string                  `json:"name,omitempty"`
+	Description    string                  `json:"description,omitempty"`
+	Enabled        bool                    `json:"isEnabled"`
+	SourceMatchers []InhibitionRuleMatcher `json:"sourceMatchers"`
+	TargetMatchers []InhibitionRuleMatcher `json:"targetMatchers"`
+	Equal          []string                `json:"equal,omitempty"`
+
+	Version int `json:"version,omitempty"`
+	ID      int `json:"id,omitempty"`
+}
+
+type InhibitionRuleMatcher struct {
+	LabelName string `json:"labelName"`
+	Operator  string `json:"operator"`
+	Value     string `json:"value"`
+}
+
 type OrganizationSecure struct {
 	cloudauth.CloudOrganization
 }
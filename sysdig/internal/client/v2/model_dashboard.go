@@ -299,35 +299,77 @@ func (q *AdvancedQueries) WithTimeFormat(f *Format) *AdvancedQueries {
 }
 
 type Panels struct {
-	ID                     int                  `json:"id"`
-	Name                   string               `json:"name"`
-	Description            string               `json:"description"`
-	AxesConfiguration      *AxesConfiguration   `json:"axesConfiguration,omitempty"`
-	LegendConfiguration    *LegendConfiguration `json:"legendConfiguration,omitempty"`
-	ApplyScopeToAll        bool                 `json:"applyScopeToAll,omitempty"`
-	ApplySegmentationToAll bool                 `json:"applySegmentationToAll,omitempty"`
-	AdvancedQueries        []*AdvancedQueries   `json:"advancedQueries,omitempty"`
-	NumberThresholds       *NumberThresholds    `json:"numberThresholds,omitempty"`
-	MarkdownSource         *string              `json:"markdownSource,omitempty"`
-	PanelTitleVisible      bool                 `json:"panelTitleVisible"`
-	TextAutosized          bool                 `json:"textAutosized"`
-	TransparentBackground  bool                 `json:"transparentBackground"`
-	Type                   PanelType            `json:"type"`
+	ID                     int                     `json:"id"`
+	Name                   string                  `json:"name"`
+	Description            string                  `json:"description"`
+	AxesConfiguration      *AxesConfiguration      `json:"axesConfiguration,omitempty"`
+	LegendConfiguration    *LegendConfiguration    `json:"legendConfiguration,omitempty"`
+	ApplyScopeToAll        bool                    `json:"applyScopeToAll,omitempty"`
+	ApplySegmentationToAll bool                    `json:"applySegmentationToAll,omitempty"`
+	AdvancedQueries        []*AdvancedQueries      `json:"advancedQueries,omitempty"`
+	NumberThresholds       *NumberThresholds       `json:"numberThresholds,omitempty"`
+	MarkdownSource         *string                 `json:"markdownSource,omitempty"`
+	PanelTitleVisible      bool                    `json:"panelTitleVisible"`
+	TextAutosized          bool                    `json:"textAutosized"`
+	TransparentBackground  bool                    `json:"transparentBackground"`
+	Type                   PanelType               `json:"type"`
+	TableConfiguration     *TableConfiguration     `json:"tableConfiguration,omitempty"`
+	ToplistConfiguration   *ToplistConfiguration   `json:"toplistConfiguration,omitempty"`
+	HistogramConfiguration *HistogramConfiguration `json:"histogramConfiguration,omitempty"`
+	EventsQueryParams      *QueryParams            `json:"eventsQueryParams,omitempty"`
 	// Just a helper to the client, the actual field is in Dashboard
 	Layout *Layout `json:"-"`
 }
 
 type PanelType string
 
+// the panel types as exported with the dashboards from the Sysdig UI
 const (
-	PanelTypeTimechart PanelType = "advancedTimechart"
-	PanelTypeNumber    PanelType = "advancedNumber"
-	PanelTypeText      PanelType = "text"
+	PanelTypeTimechart   PanelType = "advancedTimechart"
+	PanelTypeNumber      PanelType = "advancedNumber"
+	PanelTypeText        PanelType = "text"
+	PanelTypeTable       PanelType = "advancedTable"
+	PanelTypeToplist     PanelType = "advancedToplist"
+	PanelTypeHistogram   PanelType = "advancedHistogram"
+	PanelTypeBarChart    PanelType = "advancedBarChart"
+	PanelTypeEventStream PanelType = "eventStream"
 )
 
+// panelTypeMaxQueries is the maximum number of queries of the panel types that do not accept any number of them
+var panelTypeMaxQueries = map[PanelType]int{
+	PanelTypeNumber:      1,
+	PanelTypeToplist:     1,
+	PanelTypeHistogram:   1,
+	PanelTypeBarChart:    1,
+	PanelTypeText:        0,
+	PanelTypeEventStream: 0,
+}
+
+type TableColumn struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName"`
+	Visible     bool   `json:"visible"`
+}
+
+type TableConfiguration struct {
+	Columns []TableColumn `json:"columns"`
+}
+
+type ToplistConfiguration struct {
+	SortDirection string `json:"sortDirection"`
+	Limit         int    `json:"limit"`
+}
+
+type HistogramConfiguration struct {
+	NumberOfBuckets int `json:"numberOfBuckets"`
+}
+
 func (p *Panels) AddQueries(queries ...*AdvancedQueries) (*Panels, error) {
-	if p.Type == PanelTypeNumber && len(p.AdvancedQueries)+len(queries) > 1 {
-		return nil, fmt.Errorf("a panel of type 'number' can only contain one query")
+	if maxQueries, ok := panelTypeMaxQueries[p.Type]; ok && len(p.AdvancedQueries)+len(queries) > maxQueries {
+		if maxQueries == 0 {
+			return nil, fmt.Errorf("a panel of type '%s' cannot contain queries", p.Type)
+		}
+		return nil, fmt.Errorf("a panel of type '%s' can only contain one query", p.Type)
 	}
 
 	maxIndex := 0
@@ -373,8 +415,15 @@ func (p *Panels) WithLayout(xPos, yPos, width, height int) (*Panels, error) {
 }
 
 type NumberThresholds struct {
-	Base   NumberThresholdBase `json:"base"`
-	Values []interface{}       `json:"values"`
+	Base   NumberThresholdBase    `json:"base"`
+	Values []NumberThresholdValue `json:"values"`
+}
+
+type NumberThresholdValue struct {
+	Value       float64 `json:"value"`
+	Severity    string  `json:"severity"`
+	DisplayText string  `json:"displayText"`
+	InputFormat string  `json:"inputFormat"`
 }
 
 type NumberThresholdBase struct {
@@ -382,6 +431,15 @@ type NumberThresholdBase struct {
 	Severity    string `json:"severity"`
 }
 
+const (
+	TeamSharingTypeNone     = "NONE"
+	TeamSharingTypeAll      = "ALL"
+	TeamSharingTypeSelected = "SELECTED"
+
+	TeamSharingRoleRead = "ROLE_RESOURCE_READ"
+	TeamSharingRoleEdit = "ROLE_RESOURCE_EDIT"
+)
+
 type TeamSharingOptions struct {
 	Type          string        `json:"type"`
 	UserTeamsRole string        `json:"userTeamsRole"`
@@ -405,6 +463,10 @@ type ScopeExpressionList struct {
 	Value       []string    `json:"value"`
 	Descriptor  interface{} `json:"descriptor"`
 	IsVariable  bool        `json:"isVariable"`
+	// Only for variables
+	Title         string   `json:"title,omitempty"`
+	DefaultValues []string `json:"defaultValues,omitempty"`
+	MultiSelect   *bool    `json:"multiSelect,omitempty"`
 }
 
 type Dashboard struct {
@@ -433,12 +495,33 @@ type Dashboard struct {
 	CreatedOnDate           string                 `json:"createdOnDate"`
 	ModifiedOnDate          string                 `json:"modifiedOnDate"`
 	TeamSharingOptions      TeamSharingOptions     `json:"teamSharingOptions"`
+	Folder                  string                 `json:"folder,omitempty"`
 }
 
 type dashboardWrapper struct {
 	Dashboard *Dashboard `json:"dashboard"`
 }
 
+type dashboardListWrapper struct {
+	Dashboards []*Dashboard `json:"dashboards"`
+}
+
+// DashboardTemplate is a dashboard of the Sysdig dashboard library, the version changes with each upstream update
+type DashboardTemplate struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Version   string     `json:"version"`
+	Dashboard *Dashboard `json:"dashboard"`
+}
+
+type dashboardTemplateWrapper struct {
+	DashboardTemplate *DashboardTemplate `json:"dashboardTemplate"`
+}
+
+type dashboardJSONWrapper struct {
+	Dashboard map[string]interface{} `json:"dashboard"`
+}
+
 func (db *Dashboard) AddPanels(panels ...*Panels) {
 	maxPanelID := 0
 	for _, existingPanel := range db.Panels {
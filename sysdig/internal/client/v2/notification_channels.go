@@ -4,23 +4,42 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"sync"
 )
 
 const (
-	GetNotificationChannels = "%s/api/notificationChannels"
-	GetNotificationChannel  = "%s/api/notificationChannels/%d"
+	GetNotificationChannels  = "%s/api/notificationChannels"
+	GetNotificationChannel   = "%s/api/notificationChannels/%d"
+	TestNotificationChannel  = "%s/api/notificationChannels/%d/test"
+	ListNotificationChannels = "%s/api/notificationChannels?offset=%d&limit=%d"
+
+	notificationChannelsPageSize = 100
 )
 
 var NotificationChannelNotFound = errors.New("notification channel not found")
 
+var NotificationChannelTeamsNotKept = errors.New("the teams the notification channel is shared with were not kept by Sysdig")
+
+// notificationChannelCache holds the notification channels listed by FindNotificationChannelByName
+type notificationChannelCache struct {
+	sync.Mutex
+
+	channels []NotificationChannel
+	cached   bool
+}
+
 type NotificationChannelInterface interface {
 	Base
 	GetNotificationChannelById(ctx context.Context, id int) (NotificationChannel, error)
 	GetNotificationChannelByName(ctx context.Context, name string) (NotificationChannel, error)
+	FindNotificationChannelByName(ctx context.Context, name string) (NotificationChannel, error)
+	ListNotificationChannels(ctx context.Context) ([]NotificationChannel, error)
 	CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error)
 	UpdateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error)
 	DeleteNotificationChannel(ctx context.Context, id int) error
+	TestNotificationChannel(ctx context.Context, id int) (NotificationChannelTestResult, error)
 }
 
 func (client *Client) GetNotificationChannelById(ctx context.Context, id int) (NotificationChannel, error) {
@@ -50,31 +69,119 @@ func (client *Client) GetNotificationChannelById(ctx context.Context, id int) (N
 }
 
 func (client *Client) GetNotificationChannelByName(ctx context.Context, name string) (NotificationChannel, error) {
-	response, err := client.requester.Request(ctx, http.MethodGet, client.GetNotificationChannelsUrl(), nil)
+	channels, err := client.ListNotificationChannels(ctx)
 	if err != nil {
-		return NotificationChannel{}, nil
+		return NotificationChannel{}, err
 	}
-	defer response.Body.Close()
 
-	if response.StatusCode != http.StatusOK {
-		return NotificationChannel{}, client.ErrorFromResponse(response)
+	for _, channel := range channels {
+		if channel.Name == name {
+			return channel, nil
+		}
 	}
 
-	wrapper, err := Unmarshal[notificationChannelListWrapper](response.Body)
-	if err != nil {
-		return NotificationChannel{}, err
+	return NotificationChannel{}, fmt.Errorf("notification channel with name: %s does not exist", name)
+}
+
+// FindNotificationChannelByName returns the only notification channel with the given name.
+// The channels are looked up in a list cached per client, which is fetched again when the name is not found in it.
+func (client *Client) FindNotificationChannelByName(ctx context.Context, name string) (NotificationChannel, error) {
+	client.notificationChannels.Lock()
+	defer client.notificationChannels.Unlock()
+
+	channels, cached := client.notificationChannels.channels, client.notificationChannels.cached
+	matches := notificationChannelsWithName(channels, name)
+	if len(matches) == 0 {
+		log.Printf("[DEBUG] FindNotificationChannelByName for %s: fetching all notification channels", name)
+		var err error
+		channels, err = client.ListNotificationChannels(ctx)
+		if err != nil {
+			return NotificationChannel{}, err
+		}
+		client.notificationChannels.channels, client.notificationChannels.cached = channels, true
+		matches = notificationChannelsWithName(channels, name)
+	} else if cached {
+		log.Printf("[DEBUG] FindNotificationChannelByName for %s: using cached notification channels", name)
 	}
 
-	for _, channel := range wrapper.NotificationChannels {
+	switch len(matches) {
+	case 0:
+		return NotificationChannel{}, fmt.Errorf("%w: no notification channel with name %q", NotificationChannelNotFound, name)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]int, 0, len(matches))
+		for _, channel := range matches {
+			ids = append(ids, channel.ID)
+		}
+		return NotificationChannel{}, fmt.Errorf("the notification channel name %q is ambiguous, it matches the channels with IDs %v, please use the ID instead", name, ids)
+	}
+}
+
+func notificationChannelsWithName(channels []NotificationChannel, name string) []NotificationChannel {
+	var matches []NotificationChannel
+	for _, channel := range channels {
 		if channel.Name == name {
-			return channel, nil
+			matches = append(matches, channel)
 		}
 	}
+	return matches
+}
 
-	return NotificationChannel{}, fmt.Errorf("notification channel with name: %s does not exist", name)
+func (client *Client) invalidateNotificationChannelCache() {
+	client.notificationChannels.Lock()
+	defer client.notificationChannels.Unlock()
+
+	client.notificationChannels.channels, client.notificationChannels.cached = nil, false
+}
+
+// ListNotificationChannels fetches all the notification channels page by page, until a page is not full.
+// A backend ignoring the paging parameters returns all the channels at once, which also ends the listing.
+func (client *Client) ListNotificationChannels(ctx context.Context) ([]NotificationChannel, error) {
+	var channels []NotificationChannel
+	seen := map[int]bool{}
+	for offset := 0; ; offset += notificationChannelsPageSize {
+		page, err := client.listNotificationChannelsPage(ctx, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, channel := range page {
+			if !seen[channel.ID] {
+				seen[channel.ID] = true
+				channels = append(channels, channel)
+				added++
+			}
+		}
+		if len(page) < notificationChannelsPageSize || added == 0 {
+			return channels, nil
+		}
+	}
+}
+
+func (client *Client) listNotificationChannelsPage(ctx context.Context, offset int) ([]NotificationChannel, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.ListNotificationChannelsUrl(offset), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, client.ErrorFromResponse(response)
+	}
+
+	wrapper, err := Unmarshal[notificationChannelListWrapper](response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapper.NotificationChannels, nil
 }
 
 func (client *Client) CreateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error) {
+	defer client.invalidateNotificationChannelCache()
+
 	payload, err := Marshal(notificationChannelWrapper{
 		NotificationChannel: channel,
 	})
@@ -97,10 +204,20 @@ func (client *Client) CreateNotificationChannel(ctx context.Context, channel Not
 		return NotificationChannel{}, err
 	}
 
+	// the channel is not left shared with other teams than the requested ones
+	if !notificationChannelTeamsKept(channel, wrapper.NotificationChannel) {
+		if err := client.DeleteNotificationChannel(ctx, wrapper.NotificationChannel.ID); err != nil {
+			return NotificationChannel{}, fmt.Errorf("%w, and the notification channel %d could not be deleted: %v", NotificationChannelTeamsNotKept, wrapper.NotificationChannel.ID, err)
+		}
+		return NotificationChannel{}, NotificationChannelTeamsNotKept
+	}
+
 	return wrapper.NotificationChannel, nil
 }
 
 func (client *Client) UpdateNotificationChannel(ctx context.Context, channel NotificationChannel) (NotificationChannel, error) {
+	defer client.invalidateNotificationChannelCache()
+
 	payload, err := Marshal(notificationChannelWrapper{
 		NotificationChannel: channel,
 	})
@@ -123,10 +240,41 @@ func (client *Client) UpdateNotificationChannel(ctx context.Context, channel Not
 		return NotificationChannel{}, err
 	}
 
+	if !notificationChannelTeamsKept(channel, wrapper.NotificationChannel) {
+		return NotificationChannel{}, NotificationChannelTeamsNotKept
+	}
+
 	return wrapper.NotificationChannel, nil
 }
 
+// notificationChannelTeamsKept checks that the teams requested for a channel are the ones it is shared with, as a
+// backend not supporting teamIds ignores them and leaves the channel shared with all the teams
+func notificationChannelTeamsKept(requested, returned NotificationChannel) bool {
+	if requested.TeamIDs == nil {
+		return true
+	}
+	var returnedIDs []int
+	if returned.TeamIDs != nil {
+		returnedIDs = *returned.TeamIDs
+	}
+	if len(*requested.TeamIDs) != len(returnedIDs) {
+		return false
+	}
+	teams := map[int]bool{}
+	for _, id := range returnedIDs {
+		teams[id] = true
+	}
+	for _, id := range *requested.TeamIDs {
+		if !teams[id] {
+			return false
+		}
+	}
+	return true
+}
+
 func (client *Client) DeleteNotificationChannel(ctx context.Context, id int) error {
+	defer client.invalidateNotificationChannelCache()
+
 	response, err := client.requester.Request(ctx, http.MethodDelete, client.GetNotificationChannelUrl(id), nil)
 	if err != nil {
 		return err
@@ -140,10 +288,44 @@ func (client *Client) DeleteNotificationChannel(ctx context.Context, id int) err
 	return nil
 }
 
+// TestNotificationChannel sends a test notification with the channel. The delivery failures reported by the API are
+// returned in the result, an error being only returned when the test could not be run.
+func (client *Client) TestNotificationChannel(ctx context.Context, id int) (NotificationChannelTestResult, error) {
+	response, err := client.requester.Request(ctx, http.MethodPost, client.TestNotificationChannelUrl(id), nil)
+	if err != nil {
+		return NotificationChannelTestResult{}, err
+	}
+	defer response.Body.Close()
+
+	// only an unprocessable entity reports the delivery of the notification as failed, the other errors such as an
+	// invalid request, a rate limit or an outage of the API failing the test itself
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return NotificationChannelTestResult{}, NotificationChannelNotFound
+	case response.StatusCode == http.StatusUnprocessableEntity:
+		return NotificationChannelTestResult{
+			Success: false,
+			Message: client.ErrorFromResponse(response).Error(),
+		}, nil
+	case response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices:
+		return NotificationChannelTestResult{}, client.ErrorFromResponse(response)
+	}
+
+	return NotificationChannelTestResult{Success: true}, nil
+}
+
 func (client *Client) GetNotificationChannelsUrl() string {
 	return fmt.Sprintf(GetNotificationChannels, client.config.url)
 }
 
+func (client *Client) ListNotificationChannelsUrl(offset int) string {
+	return fmt.Sprintf(ListNotificationChannels, client.config.url, offset, notificationChannelsPageSize)
+}
+
 func (client *Client) GetNotificationChannelUrl(id int) string {
 	return fmt.Sprintf(GetNotificationChannel, client.config.url, id)
 }
+
+func (client *Client) TestNotificationChannelUrl(id int) string {
+	return fmt.Sprintf(TestNotificationChannel, client.config.url, id)
+}
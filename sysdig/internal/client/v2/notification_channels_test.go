@@ -0,0 +1,295 @@
+//go:build unit
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindNotificationChannelByName(t *testing.T) {
+	requests := 0
+	channels := `{"id": 1, "name": "email", "type": "EMAIL"}, {"id": 2, "name": "pager", "type": "PAGER_DUTY"}, {"id": 3, "name": "pager", "type": "PAGER_DUTY"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprintf(w, `{"notificationChannels": [%s]}`, channels)
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := context.Background()
+
+	channel, err := client.FindNotificationChannelByName(ctx, "email")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if channel.ID != 1 || channel.Type != "EMAIL" {
+		t.Errorf("expected channel 1 of type EMAIL, got %+v", channel)
+	}
+
+	_, err = client.FindNotificationChannelByName(ctx, "email")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the notification channels to be cached, got %d requests", requests)
+	}
+
+	_, err = client.FindNotificationChannelByName(ctx, "pager")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected ambiguous name error, got %v", err)
+	}
+
+	_, err = client.FindNotificationChannelByName(ctx, "slack")
+	if !errors.Is(err, NotificationChannelNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the notification channels to be fetched again for a missing name, got %d requests", requests)
+	}
+
+	channels += `, {"id": 4, "name": "slack", "type": "SLACK"}`
+	channel, err = client.FindNotificationChannelByName(ctx, "slack")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if channel.ID != 4 {
+		t.Errorf("expected channel 4, got %+v", channel)
+	}
+}
+
+func TestListNotificationChannels(t *testing.T) {
+	total := notificationChannelsPageSize + 20
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, limit := 0, 0
+		_, _ = fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		_, _ = fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		var page []string
+		for id := offset + 1; id <= total && id <= offset+limit; id++ {
+			page = append(page, fmt.Sprintf(`{"id": %d, "name": "channel-%d", "type": "EMAIL"}`, id, id))
+		}
+		_, _ = fmt.Fprintf(w, `{"notificationChannels": [%s]}`, strings.Join(page, ","))
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := context.Background()
+
+	channels, err := client.ListNotificationChannels(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(channels) != total {
+		t.Errorf("expected %d channels, got %d", total, len(channels))
+	}
+	if strings.Join(offsets, ",") != fmt.Sprintf("0,%d", notificationChannelsPageSize) {
+		t.Errorf("expected two pages to be requested, got the offsets %v", offsets)
+	}
+
+	channel, err := client.GetNotificationChannelByName(ctx, fmt.Sprintf("channel-%d", total))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if channel.ID != total {
+		t.Errorf("expected channel %d, got %+v", total, channel)
+	}
+}
+
+func TestListNotificationChannelsUnpaged(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var page []string
+		for id := 1; id <= notificationChannelsPageSize; id++ {
+			page = append(page, fmt.Sprintf(`{"id": %d, "name": "channel-%d", "type": "EMAIL"}`, id, id))
+		}
+		_, _ = fmt.Fprintf(w, `{"notificationChannels": [%s]}`, strings.Join(page, ","))
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+
+	channels, err := client.ListNotificationChannels(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(channels) != notificationChannelsPageSize || requests != 2 {
+		t.Errorf("expected the listing to stop on a repeated page, got %d channels in %d requests", len(channels), requests)
+	}
+}
+
+func TestCreateNotificationChannelOptions(t *testing.T) {
+	tests := []struct {
+		channel NotificationChannel
+		options map[string]interface{}
+	}{
+		{
+			channel: NotificationChannel{Type: "SERVICENOW", Options: NotificationChannelOptions{Url: "https://example.service-now.com", Username: "sysdig", Password: "secret"}},
+			options: map[string]interface{}{"url": "https://example.service-now.com", "username": "sysdig", "password": "secret"},
+		},
+		{
+			channel: NotificationChannel{Type: "JIRA", Options: NotificationChannelOptions{Url: "https://example.atlassian.net", Username: "sysdig@example.com", APIToken: "token", Project: "OPS", IssueType: "Task"}},
+			options: map[string]interface{}{"url": "https://example.atlassian.net", "username": "sysdig@example.com", "apiToken": "token", "project": "OPS", "issueType": "Task"},
+		},
+		{
+			channel: NotificationChannel{Type: "WEBEX", Options: NotificationChannelOptions{RoomId: "room", BotToken: "token"}},
+			options: map[string]interface{}{"roomId": "room", "botToken": "token"},
+		},
+		{
+			channel: NotificationChannel{Type: "ZENDUTY", Options: NotificationChannelOptions{RoutingKey: "key"}},
+			options: map[string]interface{}{"routingKey": "key"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.channel.Type, func(t *testing.T) {
+			var sent map[string]map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+					t.Errorf("invalid request body: %v", err)
+				}
+				_, _ = fmt.Fprintf(w, `{"notificationChannel": {"id": 1, "type": %q}}`, test.channel.Type)
+			}))
+			defer server.Close()
+
+			client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+			channel, err := client.CreateNotificationChannel(context.Background(), test.channel)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if channel.ID != 1 {
+				t.Errorf("expected channel 1, got %+v", channel)
+			}
+
+			if sent["notificationChannel"]["type"] != test.channel.Type {
+				t.Errorf("expected type %s, got %v", test.channel.Type, sent["notificationChannel"]["type"])
+			}
+			options, _ := sent["notificationChannel"]["options"].(map[string]interface{})
+			for k, v := range test.options {
+				if options[k] != v {
+					t.Errorf("expected option %s to be %v, got %v", k, v, options[k])
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationChannelTeams(t *testing.T) {
+	keepTeams := true
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		var body map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("unexpected body: %v", err)
+		}
+		if !keepTeams {
+			delete(body["notificationChannel"], "teamIds")
+		}
+		body["notificationChannel"]["id"] = 1
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := context.Background()
+
+	created, err := client.CreateNotificationChannel(ctx, NotificationChannel{Name: "email", Type: "EMAIL", TeamIDs: &[]int{2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.TeamIDs == nil || len(*created.TeamIDs) != 2 {
+		t.Errorf("expected the channel to be shared with 2 teams, got %+v", created.TeamIDs)
+	}
+
+	keepTeams = false
+	_, err = client.CreateNotificationChannel(ctx, NotificationChannel{Name: "email", Type: "EMAIL", TeamIDs: &[]int{2, 3}})
+	if !errors.Is(err, NotificationChannelTeamsNotKept) {
+		t.Errorf("expected teams not kept error, got %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "/api/notificationChannels/1" {
+		t.Errorf("expected the created channel to be deleted, got %v", deleted)
+	}
+
+	_, err = client.UpdateNotificationChannel(ctx, NotificationChannel{ID: 1, Name: "email", Type: "EMAIL", TeamIDs: &[]int{2}})
+	if !errors.Is(err, NotificationChannelTeamsNotKept) {
+		t.Errorf("expected teams not kept error, got %v", err)
+	}
+
+	_, err = client.UpdateNotificationChannel(ctx, NotificationChannel{ID: 1, Name: "email", Type: "EMAIL", TeamIDs: &[]int{}})
+	if err != nil {
+		t.Errorf("unexpected error when unsharing the channel from its teams: %v", err)
+	}
+	_, err = client.UpdateNotificationChannel(ctx, NotificationChannel{ID: 1, Name: "email", Type: "EMAIL"})
+	if err != nil {
+		t.Errorf("unexpected error without teams: %v", err)
+	}
+}
+
+func TestTestNotificationChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected a POST request, got %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/api/notificationChannels/1/test":
+			w.WriteHeader(http.StatusOK)
+		case "/api/notificationChannels/2/test":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"message": "webhook returned 500"}`)
+		case "/api/notificationChannels/4/test":
+			w.WriteHeader(http.StatusBadRequest)
+		case "/api/notificationChannels/5/test":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/api/notificationChannels/6/test":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newSysdigClient(WithURL(server.URL), WithToken("token"))
+	ctx := context.Background()
+
+	result, err := client.TestNotificationChannel(ctx, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Errorf("expected a successful delivery, got %+v", result)
+	}
+
+	result, err = client.TestNotificationChannel(ctx, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Success || result.Message != "webhook returned 500" {
+		t.Errorf("expected a failed delivery with the message of the API, got %+v", result)
+	}
+
+	_, err = client.TestNotificationChannel(ctx, 3)
+	if !errors.Is(err, NotificationChannelNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	for _, id := range []int{4, 5, 6} {
+		_, err = client.TestNotificationChannel(ctx, id)
+		if err == nil {
+			t.Errorf("expected an error for the test of the channel %d, the delivery not being attempted", id)
+		}
+	}
+}
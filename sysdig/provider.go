@@ -115,11 +115,12 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"sysdig_user":                 resourceSysdigUser(),
-			"sysdig_group_mapping":        resourceSysdigGroupMapping(),
-			"sysdig_group_mapping_config": resourceSysdigGroupMappingConfig(),
-			"sysdig_custom_role":          resourceSysdigCustomRole(),
-			"sysdig_team_service_account": resourceSysdigTeamServiceAccount(),
+			"sysdig_user":                      resourceSysdigUser(),
+			"sysdig_group_mapping":             resourceSysdigGroupMapping(),
+			"sysdig_group_mapping_config":      resourceSysdigGroupMappingConfig(),
+			"sysdig_custom_role":               resourceSysdigCustomRole(),
+			"sysdig_team_service_account":      resourceSysdigTeamServiceAccount(),
+			"sysdig_notification_channel_test": resourceSysdigNotificationChannelTest(),
 
 			"sysdig_secure_custom_policy":                                 resourceSysdigSecureCustomPolicy(),
 			"sysdig_secure_managed_policy":                                resourceSysdigSecureManagedPolicy(),
@@ -135,6 +136,14 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_secure_notification_channel_prometheus_alert_manager": resourceSysdigSecureNotificationChannelPrometheusAlertManager(),
 			"sysdig_secure_notification_channel_team_email":               resourceSysdigSecureNotificationChannelTeamEmail(),
 			"sysdig_secure_notification_channel_msteams":                  resourceSysdigSecureNotificationChannelMSTeams(),
+			"sysdig_secure_notification_channel_google_chat":              resourceSysdigSecureNotificationChannelGoogleChat(),
+			"sysdig_secure_notification_channel_custom_webhook":           resourceSysdigSecureNotificationChannelCustomWebhook(),
+			"sysdig_secure_notification_channel_ibm_event_notification":   resourceSysdigSecureNotificationChannelIBMEventNotification(),
+			"sysdig_secure_notification_channel_ibm_function":             resourceSysdigSecureNotificationChannelIBMFunction(),
+			"sysdig_secure_notification_channel_servicenow":               resourceSysdigSecureNotificationChannelServiceNow(),
+			"sysdig_secure_notification_channel_jira":                     resourceSysdigSecureNotificationChannelJira(),
+			"sysdig_secure_notification_channel_webex":                    resourceSysdigSecureNotificationChannelWebex(),
+			"sysdig_secure_notification_channel_zenduty":                  resourceSysdigSecureNotificationChannelZenduty(),
 			"sysdig_secure_rule_container":                                resourceSysdigSecureRuleContainer(),
 			"sysdig_secure_rule_filesystem":                               resourceSysdigSecureRuleFilesystem(),
 			"sysdig_secure_rule_network":                                  resourceSysdigSecureRuleNetwork(),
@@ -152,6 +161,8 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_secure_cloud_auth_account":                            resourceSysdigSecureCloudauthAccount(),
 
 			"sysdig_monitor_silence_rule":                                  resourceSysdigMonitorSilenceRule(),
+			"sysdig_monitor_inhibition_rule":                               resourceSysdigMonitorInhibitionRule(),
+			"sysdig_monitor_slo":                                           resourceSysdigMonitorSLO(),
 			"sysdig_monitor_alert_downtime":                                resourceSysdigMonitorAlertDowntime(),
 			"sysdig_monitor_alert_metric":                                  resourceSysdigMonitorAlertMetric(),
 			"sysdig_monitor_alert_event":                                   resourceSysdigMonitorAlertEvent(),
@@ -165,6 +176,8 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_monitor_alert_v2_change":                               resourceSysdigMonitorAlertV2Change(),
 			"sysdig_monitor_alert_v2_form_based_prometheus":                resourceSysdigMonitorAlertV2FormBasedPrometheus(),
 			"sysdig_monitor_dashboard":                                     resourceSysdigMonitorDashboard(),
+			"sysdig_monitor_dashboard_json":                                resourceSysdigMonitorDashboardJSON(),
+			"sysdig_monitor_dashboard_from_template":                       resourceSysdigMonitorDashboardFromTemplate(),
 			"sysdig_monitor_notification_channel_email":                    resourceSysdigMonitorNotificationChannelEmail(),
 			"sysdig_monitor_notification_channel_opsgenie":                 resourceSysdigMonitorNotificationChannelOpsGenie(),
 			"sysdig_monitor_notification_channel_pagerduty":                resourceSysdigMonitorNotificationChannelPagerduty(),
@@ -179,6 +192,11 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_monitor_notification_channel_custom_webhook":           resourceSysdigMonitorNotificationChannelCustomWebhook(),
 			"sysdig_monitor_notification_channel_ibm_event_notification":   resourceSysdigMonitorNotificationChannelIBMEventNotification(),
 			"sysdig_monitor_notification_channel_ibm_function":             resourceSysdigMonitorNotificationChannelIBMFunction(),
+			"sysdig_monitor_notification_channel_servicenow":               resourceSysdigMonitorNotificationChannelServiceNow(),
+			"sysdig_monitor_notification_channel_jira":                     resourceSysdigMonitorNotificationChannelJira(),
+			"sysdig_monitor_notification_channel_webex":                    resourceSysdigMonitorNotificationChannelWebex(),
+			"sysdig_monitor_notification_channel_zenduty":                  resourceSysdigMonitorNotificationChannelZenduty(),
+			"sysdig_monitor_notification_routing":                          resourceSysdigMonitorNotificationRouting(),
 			"sysdig_monitor_team":                                          resourceSysdigMonitorTeam(),
 			"sysdig_monitor_cloud_account":                                 resourceSysdigMonitorCloudAccount(),
 			"sysdig_secure_posture_zone":                                   resourceSysdigSecurePostureZone(),
@@ -197,6 +215,16 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_secure_notification_channel_msteams":                  dataSourceSysdigSecureNotificationChannelMSTeams(),
 			"sysdig_secure_notification_channel_prometheus_alert_manager": dataSourceSysdigSecureNotificationChannelPrometheusAlertManager(),
 			"sysdig_secure_notification_channel_team_email":               dataSourceSysdigSecureNotificationChannelTeamEmail(),
+			"sysdig_secure_notification_channel_google_chat":              dataSourceSysdigSecureNotificationChannelGoogleChat(),
+			"sysdig_secure_notification_channel_custom_webhook":           dataSourceSysdigSecureNotificationChannelCustomWebhook(),
+			"sysdig_secure_notification_channel_ibm_event_notification":   dataSourceSysdigSecureNotificationChannelIBMEventNotification(),
+			"sysdig_secure_notification_channel_ibm_function":             dataSourceSysdigSecureNotificationChannelIBMFunction(),
+			"sysdig_secure_notification_channel_servicenow":               dataSourceSysdigSecureNotificationChannelServiceNow(),
+			"sysdig_secure_notification_channel_jira":                     dataSourceSysdigSecureNotificationChannelJira(),
+			"sysdig_secure_notification_channel_webex":                    dataSourceSysdigSecureNotificationChannelWebex(),
+			"sysdig_secure_notification_channel_zenduty":                  dataSourceSysdigSecureNotificationChannelZenduty(),
+			"sysdig_secure_notification_channels":                         dataSourceSysdigSecureNotificationChannels(),
+			"sysdig_secure_notification_channel_sharing":                  dataSourceSysdigSecureNotificationChannelSharing(),
 			"sysdig_secure_custom_policy":                                 dataSourceSysdigSecureCustomPolicy(),
 			"sysdig_secure_managed_policy":                                dataSourceSysdigSecureManagedPolicy(),
 			"sysdig_secure_managed_ruleset":                               dataSourceSysdigSecureManagedRuleset(),
@@ -230,7 +258,18 @@ func (p *SysdigProvider) Provider() *schema.Provider {
 			"sysdig_monitor_notification_channel_custom_webhook":           dataSourceSysdigMonitorNotificationChannelCustomWebhook(),
 			"sysdig_monitor_notification_channel_ibm_event_notification":   dataSourceSysdigMonitorNotificationChannelIBMEventNotification(),
 			"sysdig_monitor_notification_channel_ibm_function":             dataSourceSysdigMonitorNotificationChannelIBMFunction(),
+			"sysdig_monitor_notification_channel_servicenow":               dataSourceSysdigMonitorNotificationChannelServiceNow(),
+			"sysdig_monitor_notification_channel_jira":                     dataSourceSysdigMonitorNotificationChannelJira(),
+			"sysdig_monitor_notification_channel_webex":                    dataSourceSysdigMonitorNotificationChannelWebex(),
+			"sysdig_monitor_notification_channel_zenduty":                  dataSourceSysdigMonitorNotificationChannelZenduty(),
+			"sysdig_monitor_notification_channels":                         dataSourceSysdigMonitorNotificationChannels(),
+			"sysdig_monitor_notification_channel_sharing":                  dataSourceSysdigMonitorNotificationChannelSharing(),
+			"sysdig_notification_channel_status":                           dataSourceSysdigNotificationChannelStatus(),
 			"sysdig_monitor_custom_role_permissions":                       dataSourceSysdigMonitorCustomRolePermissions(),
+			"sysdig_monitor_alert_notification_template":                   dataSourceSysdigMonitorAlertNotificationTemplate(),
+			"sysdig_monitor_grafana_dashboard_conversion":                  dataSourceSysdigMonitorGrafanaDashboardConversion(),
+			"sysdig_monitor_dashboard":                                     dataSourceSysdigMonitorDashboard(),
+			"sysdig_monitor_dashboards":                                    dataSourceSysdigMonitorDashboards(),
 		},
 		ConfigureContextFunc: p.providerConfigure,
 	}
@@ -90,7 +90,7 @@ func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
+		Schema: createNoDataAlertV2Schema(createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"operator": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -127,12 +127,7 @@ func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			"unreported_alert_notifications_retention_seconds": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(60),
-			},
-		})),
+		}))),
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
 			shorterTimeRangeSeconds := diff.Get("shorter_time_range_seconds").(int)
@@ -156,7 +151,12 @@ func resourceSysdigMonitorAlertV2Change() *schema.Resource {
 				return fmt.Errorf("longer_time_range_seconds can only have one of the following values if shorter_time_range_seconds is %v: %v, provided: %v", shorterTimeRangeSeconds, allowedValues, longerTimeRangeSeconds)
 			}
 
-			return nil
+			err := validateNoDataConfig(ctx, diff, i)
+			if err != nil {
+				return err
+			}
+
+			return planAlertV2NotificationChannels(ctx, diff, i)
 		},
 	}
 }
@@ -171,6 +171,11 @@ func resourceSysdigMonitorAlertV2ChangeCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2ChangeStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -225,6 +230,11 @@ func resourceSysdigMonitorAlertV2ChangeUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2ChangeStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -304,11 +314,8 @@ func buildAlertV2ChangeStruct(d *schema.ResourceData) (*v2.AlertV2Change, error)
 	// LongerRangeSec
 	config.LongerRangeSec = d.Get("longer_time_range_seconds").(int)
 
-	var unreportedAlertNotificationsRetentionSec *int
-	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
-		u := unreportedAlertNotificationsRetentionSecInterface.(int)
-		unreportedAlertNotificationsRetentionSec = &u
-	}
+	noDataBehaviour, unreportedAlertNotificationsRetentionSec := buildNoDataConfig(d)
+	config.NoDataBehaviour = noDataBehaviour
 
 	alert := &v2.AlertV2Change{
 		AlertV2Common:                            *alertV2Common,
@@ -348,11 +355,7 @@ func updateAlertV2ChangeState(d *schema.ResourceData, alert *v2.AlertV2Change) e
 
 	_ = d.Set("longer_time_range_seconds", alert.Config.LongerRangeSec)
 
-	if alert.UnreportedAlertNotificationsRetentionSec != nil {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", *alert.UnreportedAlertNotificationsRetentionSec)
-	} else {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", nil)
-	}
+	updateNoDataConfigState(d, alert.Config.NoDataBehaviour, alert.UnreportedAlertNotificationsRetentionSec)
 
 	return nil
 }
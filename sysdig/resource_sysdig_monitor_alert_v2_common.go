@@ -1,6 +1,8 @@
 package sysdig
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
@@ -13,6 +15,29 @@ import (
 
 const AlertV2CaptureFilenameRegexp = `.*?\.scap`
 
+// alertV2NotificationTemplateVariables are the common variables filled in by Sysdig when sending a notification,
+// the list not being exhaustive the other {{__variable__}} are only reported as warnings
+var alertV2NotificationTemplateVariables = []string{
+	"__alert_name__",
+	"__alert_description__",
+	"__alert_severity__",
+	"__alert_status__",
+	"__alert_type__",
+	"__alert_url__",
+	"__alert_value__",
+	"__alert_threshold__",
+	"__alert_condition__",
+	"__alert_scope__",
+	"__alert_group__",
+	"__alert_duration__",
+	"__event_time__",
+}
+
+var (
+	alertV2NotificationTemplateExpressionRegexp = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+	alertV2NotificationTemplateVariableRegexp   = regexp.MustCompile(`^__\w+__$`)
+)
+
 func minutesToSeconds(minutes int) (seconds int) {
 	durationMinutes := time.Duration(minutes) * time.Minute
 	return int(durationMinutes.Seconds())
@@ -63,11 +88,18 @@ func createAlertV2Schema(original map[string]*schema.Schema) map[string]*schema.
 		"notification_channels": {
 			Type:     schema.TypeSet,
 			Optional: true,
+			Computed: true, // set by planAlertV2NotificationChannels with the IDs of the channels referenced by name
+			Set:      alertV2NotificationChannelHash,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"id": {
 						Type:     schema.TypeInt,
-						Required: true,
+						Optional: true,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
 					},
 					"type": {
 						Type:       schema.TypeString,
@@ -105,16 +137,19 @@ func createAlertV2Schema(original map[string]*schema.Schema) map[string]*schema.
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"subject": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateAlertV2NotificationTemplate,
 					},
 					"prepend": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateAlertV2NotificationTemplate,
 					},
 					"append": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateAlertV2NotificationTemplate,
 					},
 				},
 			},
@@ -199,6 +234,126 @@ func AlertLinkV2TypeValues() []string {
 	}
 }
 
+func AlertV2NoDataBehaviourValues() []string {
+	return []string{
+		string(v2.AlertV2NoDataBehaviourDoNothing),
+		string(v2.AlertV2NoDataBehaviourTrigger),
+		string(v2.AlertV2NoDataBehaviourResolve),
+	}
+}
+
+// validateAlertV2NotificationTemplate warns about the {{__variable__}} in the text which are not common Sysdig
+// variables, likely typos, label references such as {{kube_cluster_name}} are resolved at notification time and are
+// not checked
+func validateAlertV2NotificationTemplate(i interface{}, k string) (warnings []string, errs []error) {
+	text, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, match := range alertV2NotificationTemplateExpressionRegexp.FindAllStringSubmatch(text, -1) {
+		variable := match[1]
+		if alertV2NotificationTemplateVariableRegexp.MatchString(variable) && !contains(alertV2NotificationTemplateVariables, variable) {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown template variable {{%s}}, the common ones are %v", k, variable, alertV2NotificationTemplateVariables))
+		}
+	}
+	return
+}
+
+// alertV2NotificationChannelHash identifies the channels by the ID they are resolved to, so that referencing a channel
+// by name instead of by ID, or importing an alert, does not replace the channel while a channel recreated with the
+// same name shows up as a change of its ID. The name is only used while the ID is not resolved yet.
+func alertV2NotificationChannelHash(v interface{}) int {
+	m := v.(map[string]interface{})
+
+	key := fmt.Sprintf("id:%v", m["id"])
+	if id, _ := m["id"].(int); id == 0 {
+		key = fmt.Sprintf("name:%v", m["name"])
+	}
+	return schema.HashString(fmt.Sprintf("%s;%v;%v;%v;%v", key,
+		m["renotify_every_minutes"], m["notify_on_resolve"], m["main_threshold"], m["warning_threshold"]))
+}
+
+// planAlertV2NotificationChannels resolves the ID of the notification channels referenced by name in the plan.
+// The notification channels being computed, they are also planned when they are removed from the configuration.
+func planAlertV2NotificationChannels(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := rawConfigAttr(diff.GetRawConfig(), "notification_channels")
+	if !config.IsWhollyKnown() {
+		return nil
+	}
+	if config.IsNull() || config.LengthInt() == 0 {
+		if diff.Get("notification_channels").(*schema.Set).Len() > 0 {
+			return diff.SetNew("notification_channels", []interface{}{})
+		}
+		return nil
+	}
+
+	for it := config.ElementIterator(); it.Next(); {
+		_, channel := it.Element()
+		hasID, hasName := !channel.GetAttr("id").IsNull(), !channel.GetAttr("name").IsNull() && channel.GetAttr("name").AsString() != ""
+		if hasID && hasName {
+			return fmt.Errorf("only one of the id or the name of the notification channel %q can be set", channel.GetAttr("name").AsString())
+		}
+		if !hasID && !hasName {
+			return fmt.Errorf("either the id or the name of the notification channel must be set")
+		}
+	}
+
+	channels, err := resolveAlertV2NotificationChannels(ctx, diff.Get("notification_channels").(*schema.Set).List(), meta, false)
+	if err != nil {
+		return err
+	}
+	return diff.SetNew("notification_channels", channels)
+}
+
+// resolveAlertV2NotificationChannelNames sets the ID of the notification channels referenced by name which were not
+// resolved in the plan, because their name was only known on apply
+func resolveAlertV2NotificationChannelNames(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	attr, ok := d.GetOk("notification_channels")
+	if !ok || attr == nil {
+		return nil
+	}
+
+	channels, err := resolveAlertV2NotificationChannels(ctx, attr.(*schema.Set).List(), meta, true)
+	if err != nil {
+		return err
+	}
+	return d.Set("notification_channels", channels)
+}
+
+func resolveAlertV2NotificationChannels(ctx context.Context, channels []interface{}, meta interface{}, unresolvedOnly bool) ([]interface{}, error) {
+	var client v2.NotificationChannelInterface
+	for _, channel := range channels {
+		channelMap := channel.(map[string]interface{})
+		name := channelMap["name"].(string)
+		if name == "" {
+			if channelMap["id"].(int) == 0 {
+				return nil, fmt.Errorf("either the id or the name of the notification channel must be set")
+			}
+			continue
+		}
+		if unresolvedOnly && channelMap["id"].(int) != 0 {
+			continue
+		}
+
+		if client == nil {
+			var err error
+			client, err = meta.(SysdigClients).commonClientV2()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		nc, err := client.FindNotificationChannelByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		channelMap["id"] = nc.ID
+	}
+	return channels, nil
+}
+
 func buildAlertV2CommonStruct(d *schema.ResourceData) *v2.AlertV2Common {
 	alert := &v2.AlertV2Common{
 		Name:     d.Get("name").(string),
@@ -316,10 +471,22 @@ func updateAlertV2CommonState(d *schema.ResourceData, alert *v2.AlertV2Common) (
 	_ = d.Set("team", alert.TeamID)
 	_ = d.Set("version", alert.Version)
 
+	// the api only knows about IDs, keep the names the channels were referenced by
+	channelNames := map[int]string{}
+	if attr, ok := d.GetOk("notification_channels"); ok && attr != nil {
+		for _, channel := range attr.(*schema.Set).List() {
+			channelMap := channel.(map[string]interface{})
+			if name := channelMap["name"].(string); name != "" {
+				channelNames[channelMap["id"].(int)] = name
+			}
+		}
+	}
+
 	var notificationChannels []interface{}
 	for _, ncc := range alert.NotificationChannelConfigList {
 		config := map[string]interface{}{
 			"id":                ncc.ChannelID,
+			"name":              channelNames[ncc.ChannelID],
 			"notify_on_resolve": ncc.OverrideOptions.NotifyOnResolve,
 		}
 
@@ -493,6 +660,64 @@ func updateScopedSegmentedConfigState(d *schema.ResourceData, config *v2.ScopedS
 	return nil
 }
 
+func createNoDataAlertV2Schema(original map[string]*schema.Schema) map[string]*schema.Schema {
+	noDataSchema := map[string]*schema.Schema{
+		"no_data_behaviour": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(v2.AlertV2NoDataBehaviourDoNothing),
+			ValidateFunc: validation.StringInSlice(AlertV2NoDataBehaviourValues(), false),
+		},
+		"unreported_alert_notifications_retention_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(60),
+		},
+	}
+
+	for k, v := range original {
+		noDataSchema[k] = v
+	}
+
+	return noDataSchema
+}
+
+func buildNoDataConfig(d *schema.ResourceData) (noDataBehaviour string, unreportedAlertNotificationsRetentionSec *int) {
+	noDataBehaviour = d.Get("no_data_behaviour").(string)
+
+	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
+		u := unreportedAlertNotificationsRetentionSecInterface.(int)
+		unreportedAlertNotificationsRetentionSec = &u
+	}
+
+	return
+}
+
+func updateNoDataConfigState(d *schema.ResourceData, noDataBehaviour string, unreportedAlertNotificationsRetentionSec *int) {
+	// alerts created before the field was available do not report it, they behave as DO_NOTHING
+	if noDataBehaviour == "" {
+		noDataBehaviour = string(v2.AlertV2NoDataBehaviourDoNothing)
+	}
+	_ = d.Set("no_data_behaviour", noDataBehaviour)
+
+	if unreportedAlertNotificationsRetentionSec != nil {
+		_ = d.Set("unreported_alert_notifications_retention_seconds", *unreportedAlertNotificationsRetentionSec)
+	} else {
+		_ = d.Set("unreported_alert_notifications_retention_seconds", nil)
+	}
+}
+
+// validateNoDataConfig rejects the no data combinations the API does not accept:
+// series that stop reporting are either triggered or resolved straight away unless the behaviour is DO_NOTHING,
+// so a retention period for their notifications can only be set in that case
+func validateNoDataConfig(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	noDataBehaviour := diff.Get("no_data_behaviour").(string)
+	if _, ok := diff.GetOk("unreported_alert_notifications_retention_seconds"); ok && noDataBehaviour != string(v2.AlertV2NoDataBehaviourDoNothing) {
+		return fmt.Errorf("unreported_alert_notifications_retention_seconds can only be set when no_data_behaviour is %s, provided: %s", v2.AlertV2NoDataBehaviourDoNothing, noDataBehaviour)
+	}
+	return nil
+}
+
 func getAlertV2Client(c SysdigClients) (v2.AlertV2Interface, error) {
 	var client v2.AlertV2Interface
 	var err error
@@ -0,0 +1,33 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertV2NotificationChannelHash(t *testing.T) {
+	channel := func(id int, name string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                     id,
+			"name":                   name,
+			"renotify_every_minutes": 0,
+			"notify_on_resolve":      true,
+			"main_threshold":         true,
+			"warning_threshold":      false,
+		}
+	}
+
+	// imported, referenced by ID or by name, the same resolved channel is the same element
+	assert.Equal(t, alertV2NotificationChannelHash(channel(1, "")), alertV2NotificationChannelHash(channel(1, "email")))
+	// a channel recreated with the same name is a change of the element
+	assert.NotEqual(t, alertV2NotificationChannelHash(channel(1, "email")), alertV2NotificationChannelHash(channel(2, "email")))
+	// channels not resolved yet are told apart by their name
+	assert.NotEqual(t, alertV2NotificationChannelHash(channel(0, "email")), alertV2NotificationChannelHash(channel(0, "pager")))
+
+	changed := channel(1, "email")
+	changed["notify_on_resolve"] = false
+	assert.NotEqual(t, alertV2NotificationChannelHash(channel(1, "email")), alertV2NotificationChannelHash(changed))
+}
@@ -52,6 +52,8 @@ func resourceSysdigMonitorAlertV2Downtime() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(60),
 			},
 		})),
+
+		CustomizeDiff: planAlertV2NotificationChannels,
 	}
 }
 
@@ -65,6 +67,11 @@ func resourceSysdigMonitorAlertV2DowntimeCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a := buildAlertV2DowntimeStruct(d)
 
 	aCreated, err := client.CreateAlertV2Downtime(ctx, *a)
@@ -116,6 +123,11 @@ func resourceSysdigMonitorAlertV2DowntimeUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a := buildAlertV2DowntimeStruct(d)
 
 	a.ID, _ = strconv.Atoi(d.Id())
@@ -61,6 +61,8 @@ func resourceSysdigMonitorAlertV2Event() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		})),
+
+		CustomizeDiff: planAlertV2NotificationChannels,
 	}
 }
 
@@ -74,6 +76,11 @@ func resourceSysdigMonitorAlertV2EventCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2EventStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -127,6 +134,11 @@ func resourceSysdigMonitorAlertV2EventUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2EventStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
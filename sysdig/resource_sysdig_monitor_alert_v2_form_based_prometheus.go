@@ -9,6 +9,7 @@ import (
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -32,7 +33,7 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheus() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
+		Schema: createNoDataAlertV2Schema(createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"operator": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -51,18 +52,9 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheus() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"no_data_behaviour": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DO_NOTHING",
-				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
-			},
-			"unreported_alert_notifications_retention_seconds": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(60),
-			},
-		})),
+		}))),
+
+		CustomizeDiff: customdiff.All(validateNoDataConfig, planAlertV2NotificationChannels),
 	}
 }
 
@@ -76,6 +68,11 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheusCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2FormBasedPrometheusStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -130,6 +127,11 @@ func resourceSysdigMonitorAlertV2FormBasedPrometheusUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2FormBasedPrometheusStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -196,13 +198,8 @@ func buildAlertV2FormBasedPrometheusStruct(d *schema.ResourceData) (*v2.AlertV2F
 	// Query
 	config.Query = d.Get("query").(string)
 
-	config.NoDataBehaviour = d.Get("no_data_behaviour").(string)
-
-	var unreportedAlertNotificationsRetentionSec *int
-	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
-		u := unreportedAlertNotificationsRetentionSecInterface.(int)
-		unreportedAlertNotificationsRetentionSec = &u
-	}
+	noDataBehaviour, unreportedAlertNotificationsRetentionSec := buildNoDataConfig(d)
+	config.NoDataBehaviour = noDataBehaviour
 
 	alert := &v2.AlertV2FormBasedPrometheus{
 		AlertV2Common:                            *alertV2Common,
@@ -234,13 +231,7 @@ func updateAlertV2FormBasedPrometheusState(d *schema.ResourceData, alert *v2.Ale
 
 	_ = d.Set("query", alert.Config.Query)
 
-	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
-
-	if alert.UnreportedAlertNotificationsRetentionSec != nil {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", *alert.UnreportedAlertNotificationsRetentionSec)
-	} else {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", nil)
-	}
+	updateNoDataConfigState(d, alert.Config.NoDataBehaviour, alert.UnreportedAlertNotificationsRetentionSec)
 
 	return nil
 }
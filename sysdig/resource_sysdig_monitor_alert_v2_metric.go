@@ -9,6 +9,7 @@ import (
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -32,7 +33,7 @@ func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
+		Schema: createNoDataAlertV2Schema(createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"trigger_after_minutes": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -65,18 +66,9 @@ func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice([]string{"avg", "sum", "min", "max"}, false),
 			},
-			"no_data_behaviour": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DO_NOTHING",
-				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
-			},
-			"unreported_alert_notifications_retention_seconds": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(60),
-			},
-		})),
+		}))),
+
+		CustomizeDiff: customdiff.All(validateNoDataConfig, planAlertV2NotificationChannels),
 	}
 }
 
@@ -90,6 +82,11 @@ func resourceSysdigMonitorAlertV2MetricCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2MetricStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -144,6 +141,11 @@ func resourceSysdigMonitorAlertV2MetricUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a, err := buildAlertV2MetricStruct(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -217,13 +219,8 @@ func buildAlertV2MetricStruct(d *schema.ResourceData) (*v2.AlertV2Metric, error)
 	metric := d.Get("metric").(string)
 	config.Metric.ID = metric
 
-	config.NoDataBehaviour = d.Get("no_data_behaviour").(string)
-
-	var unreportedAlertNotificationsRetentionSec *int
-	if unreportedAlertNotificationsRetentionSecInterface, ok := d.GetOk("unreported_alert_notifications_retention_seconds"); ok {
-		u := unreportedAlertNotificationsRetentionSecInterface.(int)
-		unreportedAlertNotificationsRetentionSec = &u
-	}
+	noDataBehaviour, unreportedAlertNotificationsRetentionSec := buildNoDataConfig(d)
+	config.NoDataBehaviour = noDataBehaviour
 
 	alert := &v2.AlertV2Metric{
 		AlertV2Common:                            *alertV2Common,
@@ -261,13 +258,7 @@ func updateAlertV2MetricState(d *schema.ResourceData, alert *v2.AlertV2Metric) e
 
 	_ = d.Set("metric", alert.Config.Metric.ID)
 
-	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
-
-	if alert.UnreportedAlertNotificationsRetentionSec != nil {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", *alert.UnreportedAlertNotificationsRetentionSec)
-	} else {
-		_ = d.Set("unreported_alert_notifications_retention_seconds", nil)
-	}
+	updateNoDataConfigState(d, alert.Config.NoDataBehaviour, alert.UnreportedAlertNotificationsRetentionSec)
 
 	return nil
 }
@@ -4,6 +4,7 @@ package sysdig_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -33,9 +34,23 @@ func TestAccAlertV2Metric(t *testing.T) {
 			{
 				Config: alertV2MetricWithNoData(rText()),
 			},
+			{
+				Config: alertV2MetricWithNoDataResolve(rText()),
+			},
+			{
+				Config:      alertV2MetricWithNoDataAndUnreportedAlertNotificationsRetentionSec(rText()),
+				ExpectError: regexp.MustCompile("unreported_alert_notifications_retention_seconds can only be set when no_data_behaviour is DO_NOTHING"),
+			},
 			{
 				Config: alertV2MetricWithNotificationChannels(rText()),
 			},
+			{
+				Config: alertV2MetricWithNotificationChannelNames(rText()),
+			},
+			{
+				Config:      alertV2MetricWithNotificationChannelIDAndName(rText()),
+				ExpectError: regexp.MustCompile("only one of the id or the name of the notification channel"),
+			},
 			{
 				Config: alertV2MetricWithDescription(rText()),
 			},
@@ -134,6 +149,41 @@ resource "sysdig_monitor_alert_v2_metric" "sample" {
 `, name)
 }
 
+func alertV2MetricWithNoDataResolve(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+
+	name = "TERRAFORM TEST - METRICV2 %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	trigger_after_minutes = 15
+	no_data_behaviour = "RESOLVE"
+
+}
+`, name)
+}
+
+func alertV2MetricWithNoDataAndUnreportedAlertNotificationsRetentionSec(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+
+	name = "TERRAFORM TEST - METRICV2 %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	trigger_after_minutes = 15
+	no_data_behaviour = "TRIGGER"
+	unreported_alert_notifications_retention_seconds = 60 * 60 * 24 * 30
+
+}
+`, name)
+}
+
 func alertV2MetricWithNotificationChannels(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_notification_channel_email" "nc_email1" {
@@ -168,6 +218,65 @@ resource "sysdig_monitor_alert_v2_metric" "sample" {
 `, name, name, name)
 }
 
+func alertV2MetricWithNotificationChannelNames(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "nc_email1" {
+	name = "%s1"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_email" "nc_email2" {
+	name = "%s2"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+
+	name = "TERRAFORM TEST - METRICV2 %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	trigger_after_minutes = 15
+	enabled = false
+	notification_channels {
+		name = sysdig_monitor_notification_channel_email.nc_email1.name
+		notify_on_resolve = false
+	}
+	notification_channels {
+		id = sysdig_monitor_notification_channel_email.nc_email2.id
+		renotify_every_minutes = 30
+	}
+}
+`, name, name, name)
+}
+
+func alertV2MetricWithNotificationChannelIDAndName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "nc_email1" {
+	name = "%s1"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+
+	name = "TERRAFORM TEST - METRICV2 %s"
+	metric = "sysdig_container_cpu_used_percent"
+	group_aggregation = "avg"
+	time_aggregation = "avg"
+	operator = ">="
+	threshold = 50
+	trigger_after_minutes = 15
+	enabled = false
+	notification_channels {
+		id = sysdig_monitor_notification_channel_email.nc_email1.id
+		name = "%s1"
+	}
+}
+`, name, name, name)
+}
+
 func alertV2MetricWithDescription(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_alert_v2_metric" "sample" {
@@ -2,12 +2,14 @@ package sysdig
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -31,7 +33,7 @@ func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createAlertV2Schema(map[string]*schema.Schema{
+		Schema: createNoDataAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"trigger_after_minutes": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -45,10 +47,27 @@ func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
-		}),
+		})),
+
+		CustomizeDiff: customdiff.All(validateNoDataConfig, planAlertV2NotificationChannels),
 	}
 }
 
+// alertV2PrometheusNoDataWarnings warns that firing alerts are not kept firing when their series are resolved as soon
+// as they stop reporting. The combination is accepted by the API, so it is only reported once applied.
+func alertV2PrometheusNoDataWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	noDataBehaviour := d.Get("no_data_behaviour").(string)
+	if _, ok := d.GetOk("keep_firing_for_minutes"); ok && noDataBehaviour == string(v2.AlertV2NoDataBehaviourResolve) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "keep_firing_for_minutes has no effect",
+			Detail:   fmt.Sprintf("keep_firing_for_minutes is not applied to the series resolved when no_data_behaviour is %s", v2.AlertV2NoDataBehaviourResolve),
+		})
+	}
+	return diags
+}
+
 func getAlertV2PrometheusClient(c SysdigClients) (v2.AlertV2PrometheusInterface, error) {
 	return getAlertV2Client(c)
 }
@@ -59,6 +78,11 @@ func resourceSysdigMonitorAlertV2PrometheusCreate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a := buildAlertV2PrometheusStruct(d)
 
 	aCreated, err := client.CreateAlertV2Prometheus(ctx, *a)
@@ -73,7 +97,7 @@ func resourceSysdigMonitorAlertV2PrometheusCreate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return alertV2PrometheusNoDataWarnings(d)
 }
 
 func resourceSysdigMonitorAlertV2PrometheusRead(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -110,6 +134,11 @@ func resourceSysdigMonitorAlertV2PrometheusUpdate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
+	err = resolveAlertV2NotificationChannelNames(ctx, d, i)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	a := buildAlertV2PrometheusStruct(d)
 
 	a.ID, _ = strconv.Atoi(d.Id())
@@ -124,7 +153,7 @@ func resourceSysdigMonitorAlertV2PrometheusUpdate(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return alertV2PrometheusNoDataWarnings(d)
 }
 
 func resourceSysdigMonitorAlertV2PrometheusDelete(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -157,10 +186,14 @@ func buildAlertV2PrometheusStruct(d *schema.ResourceData) *v2.AlertV2Prometheus
 		config.KeepFiringForSec = &kff
 	}
 
+	noDataBehaviour, unreportedAlertNotificationsRetentionSec := buildNoDataConfig(d)
+	config.NoDataBehaviour = noDataBehaviour
+
 	alert := &v2.AlertV2Prometheus{
-		AlertV2Common: *alertV2Common,
-		DurationSec:   minutesToSeconds(d.Get("trigger_after_minutes").(int)),
-		Config:        config,
+		AlertV2Common:                            *alertV2Common,
+		DurationSec:                              minutesToSeconds(d.Get("trigger_after_minutes").(int)),
+		Config:                                   config,
+		UnreportedAlertNotificationsRetentionSec: unreportedAlertNotificationsRetentionSec,
 	}
 	return alert
 }
@@ -181,5 +214,7 @@ func updateAlertV2PrometheusState(d *schema.ResourceData, alert *v2.AlertV2Prome
 		_ = d.Set("keep_firing_for_minutes", nil)
 	}
 
+	updateNoDataConfigState(d, alert.Config.NoDataBehaviour, alert.UnreportedAlertNotificationsRetentionSec)
+
 	return
 }
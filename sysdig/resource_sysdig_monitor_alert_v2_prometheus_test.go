@@ -33,6 +33,16 @@ func TestAccAlertV2Prometheus(t *testing.T) {
 			{
 				Config: alertV2PrometheusWithKeepFiringFor(rText()),
 			},
+			{
+				Config: alertV2PrometheusWithNoData(rText()),
+			},
+			{
+				Config: alertV2PrometheusWithUnreportedAlertNotificationsRetentionSec(rText()),
+			},
+			{
+				// accepted by the API, with a warning
+				Config: alertV2PrometheusWithKeepFiringForAndNoDataResolve(rText()),
+			},
 			{
 				ResourceName:      "sysdig_monitor_alert_v2_prometheus.sample",
 				ImportState:       true,
@@ -82,3 +92,46 @@ resource "sysdig_monitor_alert_v2_prometheus" "sample" {
 }
 `, name, name)
 }
+
+func alertV2PrometheusWithNoData(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+	name = "TERRAFORM TEST - PROMQL %s"
+	description = "TERRAFORM TEST - PROMQL %s"
+	severity = "high"
+	query = "(elasticsearch_jvm_memory_used_bytes{area=\"heap\"} / elasticsearch_jvm_memory_max_bytes{area=\"heap\"}) * 100 > 80"
+	trigger_after_minutes = 10
+	enabled = false
+	no_data_behaviour = "TRIGGER"
+}
+`, name, name)
+}
+
+func alertV2PrometheusWithUnreportedAlertNotificationsRetentionSec(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+	name = "TERRAFORM TEST - PROMQL %s"
+	description = "TERRAFORM TEST - PROMQL %s"
+	severity = "high"
+	query = "(elasticsearch_jvm_memory_used_bytes{area=\"heap\"} / elasticsearch_jvm_memory_max_bytes{area=\"heap\"}) * 100 > 80"
+	trigger_after_minutes = 10
+	enabled = false
+	unreported_alert_notifications_retention_seconds = 60 * 60 * 24 * 30
+}
+`, name, name)
+}
+
+func alertV2PrometheusWithKeepFiringForAndNoDataResolve(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+	name = "TERRAFORM TEST - PROMQL %s"
+	description = "TERRAFORM TEST - PROMQL %s"
+	severity = "high"
+	query = "(elasticsearch_jvm_memory_used_bytes{area=\"heap\"} / elasticsearch_jvm_memory_max_bytes{area=\"heap\"}) * 100 > 80"
+	trigger_after_minutes = 10
+	enabled = false
+	keep_firing_for_minutes = 10
+	no_data_behaviour = "RESOLVE"
+}
+`, name, name)
+}
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,7 +22,7 @@ import (
 func resourceSysdigMonitorDashboard() *schema.Resource {
 	timeout := 5 * time.Minute
 
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceSysdigDashboardCreate,
 		UpdateContext: resourceSysdigDashboardUpdate,
 		ReadContext:   resourceSysdigDashboardRead,
@@ -35,6 +38,26 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			err := validateDashboardLayout(ctx, diff, i)
+			if err != nil {
+				return err
+			}
+			err = validateDashboardPanelRepeats(ctx, diff, i)
+			if err != nil {
+				return err
+			}
+			err = planDashboardPanels(ctx, diff, i)
+			if err != nil {
+				return err
+			}
+			_, err = teamSharingFromResourceData(diff.Get("team_sharing").([]interface{}))
+			if err != nil {
+				return err
+			}
+			return validateDashboardVariables(ctx, diff, i)
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -83,6 +106,37 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 					},
 				},
 			},
+			"team_sharing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{v2.TeamSharingTypeAll, v2.TeamSharingTypeSelected}, false)),
+						},
+						"role": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          v2.TeamSharingRoleRead,
+							ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{v2.TeamSharingRoleRead, v2.TeamSharingRoleEdit}, false)),
+						},
+						"teams": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringIsNotWhiteSpace),
+			},
 			"scope": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -111,244 +165,708 @@ func resourceSysdigMonitorDashboard() *schema.Resource {
 					},
 				},
 			},
-			"panel": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"pos_x": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.IntBetween(0, 23)),
-						},
-						"pos_y": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(0)),
-						},
-						"width": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 24)),
-						},
-						"height": {
-							Type:             schema.TypeInt,
+						"name": {
+							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+							ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`), "must be a valid PromQL variable name")),
 						},
-						"name": {
+						"label": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
-						"description": {
+						"display_name": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
-						"type": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"timechart", "number", "text"}, false)),
+						"allowed_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"content": {
-							Type:     schema.TypeString,
+						"default_values": {
+							Type:     schema.TypeList,
 							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"visible_title": {
+						"multi_select": {
 							Type:     schema.TypeBool,
 							Optional: true,
-							Default:  false,
+							Default:  true,
 						},
-						"autosize_text": {
+					},
+				},
+			},
+			"event_overlay": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
-							Default:  false,
+							Default:  true,
+						},
+						"filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"severities": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"alert_statuses": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"categories": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"transparent_background": {
+						"team_scope": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
-						"query": {
-							Type:     schema.TypeSet,
-							Optional: true,
+					},
+				},
+			},
+			"panel_repeat": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"columns": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          2,
+							ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 24)),
+						},
+						"panel": {
+							Type:     schema.TypeList,
+							Required: true,
 							MinItems: 1,
+							MaxItems: 1,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"promql": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"unit": {
-										Type:             schema.TypeString,
-										Required:         true,
-										ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"percent", "data", "data rate", "number", "number rate", "time"}, false)),
-									},
-									"format": {
-										Type:     schema.TypeSet,
-										MaxItems: 1,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"decimals": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-												"display_format": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"input_format": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"min_interval": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"null_value_display_mode": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"y_axis": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									"display_info": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										MinItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"display_name": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"time_series_display_name_template": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"type": {
-													Type:             schema.TypeString,
-													Required:         true,
-													ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"lines", "stackedArea", "stackedBar"}, false)),
-												},
-											},
-										},
-									},
-								},
+								Schema: dashboardRepeatPanelSchema(),
 							},
 						},
-						"legend": {
-							Type:     schema.TypeSet,
+					},
+				},
+			},
+			"auto_layout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"row_template": {
+							Type:     schema.TypeList,
 							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"enabled": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  true,
-									},
-									"show_current": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"position": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"layout": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
+							Elem: &schema.Schema{
+								Type:             schema.TypeInt,
+								ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 24)),
 							},
 						},
 					},
 				},
 			},
+			"panel": {
+				Type: schema.TypeList,
+				// computed so that auto_layout can place the panels at plan time
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"panel", "panel_repeat"},
+				Elem: &schema.Resource{
+					Schema: dashboardPanelSchema(),
+				},
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
 	}
+
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{{
+		Version: 0,
+		Type:    resourceSysdigMonitorDashboardV0(resource).CoreConfigSchema().ImpliedType(),
+		Upgrade: resourceSysdigMonitorDashboardStateUpgradeV0,
+	}}
+	return resource
 }
 
-func getMonitorDashboardClient(c SysdigClients) (v2.DashboardInterface, error) {
-	var client v2.DashboardInterface
-	var err error
-	switch c.GetClientType() {
-	case IBMMonitor:
-		client, err = c.ibmMonitorClient()
-		if err != nil {
-			return nil, err
-		}
-	default:
-		client, err = c.sysdigMonitorClientV2()
-		if err != nil {
-			return nil, err
-		}
+// resourceSysdigMonitorDashboardV0 is the dashboard as of version 0 of its schema, when the panels were a set
+func resourceSysdigMonitorDashboardV0(resource *schema.Resource) *schema.Resource {
+	schemaV0 := map[string]*schema.Schema{}
+	for k, v := range resource.Schema {
+		schemaV0[k] = v
 	}
-	return client, nil
+	panel := *resource.Schema["panel"]
+	panel.Type = schema.TypeSet
+	panel.Required, panel.Optional, panel.Computed = true, false, false
+	schemaV0["panel"] = &panel
+	return &schema.Resource{Schema: schemaV0}
 }
 
-func resourceSysdigDashboardCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client, err := getMonitorDashboardClient(i.(SysdigClients))
+// resourceSysdigMonitorDashboardStateUpgradeV0 orders the panels, which were a set in version 0, as they are
+// returned by Sysdig, which is the order of the panel blocks in the configuration of the existing dashboards
+func resourceSysdigMonitorDashboardStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	panels, ok := rawState["panel"].([]interface{})
+	if !ok || len(panels) < 2 {
+		return rawState, nil
+	}
+	id, err := strconv.Atoi(cast.ToString(rawState["id"]))
 	if err != nil {
-		return diag.FromErr(err)
+		return rawState, nil
 	}
 
-	dashboard, err := dashboardFromResourceData(data)
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
-
-	dashboardCreated, err := client.CreateDashboard(ctx, dashboard)
+	// the dashboard is left to the next read when it cannot be retrieved
+	dashboard, err := client.GetDashboard(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return rawState, nil
 	}
 
-	data.SetId(strconv.Itoa(dashboardCreated.ID))
-	_ = data.Set("version", dashboardCreated.Version)
-
-	return nil
+	rawState["panel"] = orderStatePanels(panels, dashboard)
+	return rawState, nil
 }
 
-func resourceSysdigDashboardUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client, err := getMonitorDashboardClient(i.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
+// orderStatePanels sorts the panels of a state as the panels of the dashboard, matching them by name and position,
+// or by name only when they have moved. The panels without a match are kept at the end.
+func orderStatePanels(panels []interface{}, dashboard *v2.Dashboard) []interface{} {
+	used := make([]bool, len(panels))
+	find := func(panel *v2.Panels, layout *v2.Layout) int {
+		for i, panelItr := range panels {
+			panelInfo, ok := panelItr.(map[string]interface{})
+			if !ok || used[i] || panelInfo["name"] != panel.Name {
+				continue
+			}
+			if layout == nil || (cast.ToInt(panelInfo["pos_x"]) == layout.X && cast.ToInt(panelInfo["pos_y"]) == layout.Y) {
+				return i
+			}
+		}
+		return -1
 	}
 
-	dashboard, err := dashboardFromResourceData(data)
-	if err != nil {
-		return diag.FromErr(err)
+	ordered := make([]interface{}, 0, len(panels))
+	for _, panel := range dashboard.Panels {
+		var layout *v2.Layout
+		for j := range dashboard.Layout {
+			if dashboard.Layout[j].PanelID == panel.ID {
+				layout = dashboard.Layout[j]
+			}
+		}
+		i := find(panel, layout)
+		if i < 0 {
+			i = find(panel, nil)
+		}
+		if i >= 0 {
+			used[i] = true
+			ordered = append(ordered, panels[i])
+		}
 	}
-
-	dashboard.ID, _ = strconv.Atoi(data.Id())
-
-	_, err = client.UpdateDashboard(ctx, dashboard)
-	if err != nil {
-		return diag.FromErr(err)
+	for i, panel := range panels {
+		if !used[i] {
+			ordered = append(ordered, panel)
+		}
 	}
-
-	return nil
+	return ordered
 }
 
-func resourceSysdigDashboardRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-	client, err := getMonitorDashboardClient(i.(SysdigClients))
-	if err != nil {
-		return diag.FromErr(err)
+// dashboardPanelSchema is the schema of a panel, shared by the panel blocks and the panel_repeat templates
+func dashboardPanelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"pos_x": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateDiagFunc(validation.IntBetween(0, 23)),
+		},
+		"pos_y": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(0)),
+		},
+		"width": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 24)),
+		},
+		"height": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"type": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"timechart", "number", "text", "table", "toplist", "histogram", "bar_chart", "event_stream"}, false)),
+		},
+		"content": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"visible_title": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"autosize_text": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"transparent_background": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"query": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"promql": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"unit": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"percent", "data", "data rate", "number", "number rate", "time"}, false)),
+					},
+					"format": {
+						Type:     schema.TypeSet,
+						MaxItems: 1,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"decimals": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								"display_format": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"input_format": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"min_interval": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"null_value_display_mode": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"y_axis": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"display_info": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"display_name": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"time_series_display_name_template": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"type": {
+									Type:             schema.TypeString,
+									Required:         true,
+									ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"lines", "stackedArea", "stackedBar"}, false)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"table": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"column": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"key": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"display_name": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"visible": {
+									Type:     schema.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"toplist": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"sort_direction": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "desc",
+						ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+					},
+					"limit": {
+						Type:             schema.TypeInt,
+						Optional:         true,
+						Default:          10,
+						ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 100)),
+					},
+				},
+			},
+		},
+		"histogram": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"buckets": {
+						Type:             schema.TypeInt,
+						Required:         true,
+						ValidateDiagFunc: validateDiagFunc(validation.IntBetween(1, 100)),
+					},
+				},
+			},
+		},
+		"event_stream": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"filter": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"severities": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"alert_statuses": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"categories": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"team_scope": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"thresholds": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+					"severity": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"none", "ok", "info", "low", "medium", "high"}, false)),
+					},
+					"display_text": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"left_axis": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     dashboardAxisSchema(),
+		},
+		"right_axis": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     dashboardAxisSchema(),
+		},
+		"bottom_axis": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+		"legend": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					"show_current": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"position": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"layout": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
 	}
+}
 
-	id, err := strconv.Atoi(data.Id())
-	if err != nil {
-		return diag.FromErr(err)
+// dashboardRepeatPanelSchema is the schema of a panel_repeat template, which is placed by the repeat
+func dashboardRepeatPanelSchema() map[string]*schema.Schema {
+	panelSchema := dashboardPanelSchema()
+	delete(panelSchema, "pos_x")
+	delete(panelSchema, "pos_y")
+	delete(panelSchema, "width")
+	panelSchema["name"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile(regexp.QuoteMeta(dashboardPanelRepeatPlaceholder)), "must contain "+dashboardPanelRepeatPlaceholder+" so that the generated panels have distinct names")),
+	}
+	panelSchema["height"] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+	}
+	return panelSchema
+}
+
+func dashboardAxisSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "auto",
+			},
+			"display_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "auto",
+			},
+			"decimals": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"min_value": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0,
+			},
+			"max_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"scale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "linear",
+			},
+		},
+	}
+}
+
+func getMonitorDashboardClient(c SysdigClients) (v2.DashboardInterface, error) {
+	var client v2.DashboardInterface
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func resourceSysdigDashboardCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := dashboardFromResourceData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboardCreated, err := client.CreateDashboard(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(dashboardCreated.ID))
+	_ = data.Set("version", dashboardCreated.Version)
+
+	return dashboardFolderDiagnostics(dashboard, dashboardCreated)
+}
+
+func resourceSysdigDashboardUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := dashboardFromResourceData(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard.ID, _ = strconv.Atoi(data.Id())
+
+	// the descriptors of the scope labels are resolved by Sysdig, keep them instead of sending them empty
+	current, err := client.GetDashboard(ctx, dashboard.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keepScopeDescriptors(dashboard.ScopeExpressionList, current.ScopeExpressionList)
+
+	dashboardUpdated, err := client.UpdateDashboard(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return dashboardFolderDiagnostics(dashboard, dashboardUpdated)
+}
+
+// keepScopeDescriptors copies the descriptors of the existing scope expressions to the ones with the same label
+// and operator
+func keepScopeDescriptors(scopes []*v2.ScopeExpressionList, existingScopes []*v2.ScopeExpressionList) {
+	for _, scope := range scopes {
+		for _, existing := range existingScopes {
+			if scope.Operand == existing.Operand && scope.Operator == existing.Operator && existing.Descriptor != nil {
+				scope.Descriptor = existing.Descriptor
+				break
+			}
+		}
+	}
+}
+
+// dashboardFolderDiagnostics warns when the folder has been dropped by a tenant without dashboard folders
+func dashboardFolderDiagnostics(sent, received *v2.Dashboard) diag.Diagnostics {
+	if sent.Folder == "" || received.Folder == sent.Folder {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "dashboard folder not supported",
+		Detail:   fmt.Sprintf("the dashboard has not been placed in the folder %q, dashboard folders are not available in this Sysdig tenant", sent.Folder),
+	}}
+}
+
+func resourceSysdigDashboardRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(i.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	dashboard, err := client.GetDashboard(ctx, id)
@@ -398,7 +916,11 @@ func dashboardFromResourceData(data *schema.ResourceData) (dashboard *v2.Dashboa
 	if err != nil {
 		return nil, err
 	}
-	dashboard.ScopeExpressionList = scopes
+	variables, err := variablesFromResourceData(data)
+	if err != nil {
+		return nil, err
+	}
+	dashboard.ScopeExpressionList = append(scopes, variables...)
 
 	dashboard.AddPanels(panels...)
 
@@ -408,6 +930,15 @@ func dashboardFromResourceData(data *schema.ResourceData) (dashboard *v2.Dashboa
 	}
 	dashboard.SharingSettings = shares
 
+	dashboard.TeamSharingOptions, err = teamSharingFromResourceData(data.Get("team_sharing").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard.Folder = data.Get("folder").(string)
+
+	dashboard.EventDisplaySettings = eventOverlayFromResourceData(data)
+
 	return dashboard, nil
 }
 
@@ -427,28 +958,286 @@ func sharingFromResourceData(data *schema.ResourceData) (sharingSettings []*v2.S
 	return
 }
 
-func panelsFromResourceData(data *schema.ResourceData) (panels []*v2.Panels, err error) {
-	for _, panelItr := range data.Get("panel").(*schema.Set).List() {
-		panelInfo := panelItr.(map[string]interface{})
+// teamSharingFromResourceData shares the dashboard with all the teams or the selected ones,
+// removing the block stops sharing it
+func teamSharingFromResourceData(teamSharing []interface{}) (v2.TeamSharingOptions, error) {
+	if len(teamSharing) == 0 || teamSharing[0] == nil {
+		return v2.TeamSharingOptions{Type: v2.TeamSharingTypeNone, SelectedTeams: []interface{}{}}, nil
+	}
 
-		var panel *v2.Panels
-		switch panelInfo["type"].(string) {
-		case "timechart":
-			panel, err = timechartPanelFromResourceData(panelInfo)
-		case "number":
-			panel, err = numberPanelFromResourceData(panelInfo)
-		case "text":
-			panel, err = textPanelFromResourceData(panelInfo)
-		default:
-			return nil, fmt.Errorf("unsupported panel type %s", panelInfo["type"])
+	teamSharingInfo := teamSharing[0].(map[string]interface{})
+	options := v2.TeamSharingOptions{
+		Type:          teamSharingInfo["type"].(string),
+		UserTeamsRole: teamSharingInfo["role"].(string),
+		SelectedTeams: []interface{}{},
+	}
+	if teams, ok := teamSharingInfo["teams"].(*schema.Set); ok {
+		for _, team := range teams.List() {
+			options.SelectedTeams = append(options.SelectedTeams, team)
 		}
+	}
+
+	if options.Type == v2.TeamSharingTypeSelected && len(options.SelectedTeams) == 0 {
+		return options, fmt.Errorf("team_sharing of type %s requires at least one team", v2.TeamSharingTypeSelected)
+	}
+	if options.Type == v2.TeamSharingTypeAll && len(options.SelectedTeams) > 0 {
+		return options, fmt.Errorf("teams cannot be set on a team_sharing of type %s", v2.TeamSharingTypeAll)
+	}
+
+	return options, nil
+}
+
+// panelTypeOptions are the option blocks named after the type of the panels they apply to
+var panelTypeOptions = []string{"table", "toplist", "histogram", "event_stream"}
+
+// panelOptionsTypes are the other type specific option blocks of a panel and the panel types they apply to,
+// the bar charts having the same axes as the timecharts
+var panelOptionsTypes = map[string][]string{
+	"thresholds":  {"number"},
+	"left_axis":   {"timechart", "bar_chart"},
+	"right_axis":  {"timechart", "bar_chart"},
+	"bottom_axis": {"timechart", "bar_chart"},
+}
+
+func panelsFromResourceData(data *schema.ResourceData) (panels []*v2.Panels, err error) {
+	panelsInfo := data.Get("panel").([]interface{})
+	if autoLayout := data.Get("auto_layout").([]interface{}); len(autoLayout) > 0 {
+		autoLayoutPanels(panelsInfo, rawConfigBlocks(data.GetRawConfig(), "panel"), autoLayout[0])
+	}
+
+	for _, panelItr := range panelsInfo {
+		panel, err := panelFromResourceData(panelItr.(map[string]interface{}))
 		if err != nil {
 			return nil, err
 		}
-
 		panels = append(panels, panel)
 	}
-	return
+
+	repeatedPanels, err := repeatedPanelsFromResourceData(data.Get("panel_repeat").([]interface{}), panels)
+	if err != nil {
+		return nil, err
+	}
+	return append(panels, repeatedPanels...), nil
+}
+
+func panelFromResourceData(panelInfo map[string]interface{}) (panel *v2.Panels, err error) {
+	for _, panelType := range panelTypeOptions {
+		if panelOptionsList(panelInfo[panelType]) != nil && panelInfo["type"] != panelType {
+			return nil, fmt.Errorf("the %s block can only be set on panels of type %s, panel %q is of type %s", panelType, panelType, panelInfo["name"], panelInfo["type"])
+		}
+	}
+	for block, panelTypes := range panelOptionsTypes {
+		if panelOptionsList(panelInfo[block]) != nil && !contains(panelTypes, panelInfo["type"].(string)) {
+			return nil, fmt.Errorf("the %s block can only be set on panels of type %s, panel %q is of type %s", block, strings.Join(panelTypes, " or "), panelInfo["name"], panelInfo["type"])
+		}
+	}
+
+	switch panelInfo["type"].(string) {
+	case "timechart":
+		panel, err = timechartPanelFromResourceData(panelInfo)
+	case "number":
+		panel, err = numberPanelFromResourceData(panelInfo)
+	case "text":
+		panel, err = textPanelFromResourceData(panelInfo)
+	case "table":
+		panel, err = tablePanelFromResourceData(panelInfo)
+	case "toplist":
+		panel, err = toplistPanelFromResourceData(panelInfo)
+	case "histogram":
+		panel, err = histogramPanelFromResourceData(panelInfo)
+	case "bar_chart":
+		panel, err = barChartPanelFromResourceData(panelInfo)
+	case "event_stream":
+		panel, err = eventStreamPanelFromResourceData(panelInfo)
+	default:
+		return nil, fmt.Errorf("unsupported panel type %s", panelInfo["type"])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return panel, nil
+}
+
+// dashboardPanelRepeatPlaceholder is replaced by each value of a panel_repeat
+const dashboardPanelRepeatPlaceholder = "{{value}}"
+
+// repeatedPanelsFromResourceData generates a panel per value of each panel_repeat, in a grid placed below
+// the other panels. The panels follow the order of the values, so appending a value only adds a panel.
+func repeatedPanelsFromResourceData(panelRepeats []interface{}, placedPanels []*v2.Panels) (panels []*v2.Panels, err error) {
+	y := 0
+	for _, panel := range placedPanels {
+		if y < panel.Layout.Y+panel.Layout.H {
+			y = panel.Layout.Y + panel.Layout.H
+		}
+	}
+
+	for _, repeatItr := range panelRepeats {
+		repeatInfo := repeatItr.(map[string]interface{})
+		values := cast.ToStringSlice(repeatInfo["values"])
+		columns := repeatInfo["columns"].(int)
+		template := repeatInfo["panel"].([]interface{})[0].(map[string]interface{})
+
+		width := 24 / columns
+		height := dashboardPanelDefaultSizes[template["type"].(string)].H
+		if templateHeight := cast.ToInt(template["height"]); templateHeight > 0 {
+			height = templateHeight
+		}
+
+		for i, value := range values {
+			panelInfo := repeatedPanelInfo(template, value)
+			panelInfo["pos_x"] = i % columns * width
+			panelInfo["pos_y"] = y + i/columns*height
+			panelInfo["width"] = width
+			panelInfo["height"] = height
+
+			panel, err := panelFromResourceData(panelInfo)
+			if err != nil {
+				return nil, err
+			}
+			panels = append(panels, panel)
+		}
+
+		y += (len(values) + columns - 1) / columns * height
+	}
+	return panels, nil
+}
+
+// repeatedPanelInfo returns a copy of the panel template with the placeholder replaced by the value
+// in its name, description, content and queries
+func repeatedPanelInfo(template map[string]interface{}, value string) map[string]interface{} {
+	panelInfo := map[string]interface{}{}
+	for k, v := range template {
+		panelInfo[k] = v
+	}
+	for _, attribute := range []string{"name", "description", "content"} {
+		panelInfo[attribute] = strings.ReplaceAll(cast.ToString(template[attribute]), dashboardPanelRepeatPlaceholder, value)
+	}
+
+	if queries, ok := template["query"].(*schema.Set); ok {
+		repeatedQueries := schema.NewSet(queries.F, nil)
+		for _, queryItr := range queries.List() {
+			queryInfo := map[string]interface{}{}
+			for k, v := range queryItr.(map[string]interface{}) {
+				queryInfo[k] = v
+			}
+			queryInfo["promql"] = strings.ReplaceAll(cast.ToString(queryInfo["promql"]), dashboardPanelRepeatPlaceholder, value)
+			repeatedQueries.Add(queryInfo)
+		}
+		panelInfo["query"] = repeatedQueries
+	}
+
+	return panelInfo
+}
+
+// repeatedPanelNames counts the names of the panels generated by the panel_repeat blocks
+func repeatedPanelNames(panelRepeats []interface{}) map[string]int {
+	names := map[string]int{}
+	for _, repeatItr := range panelRepeats {
+		repeatInfo, ok := repeatItr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		templates := cast.ToSlice(repeatInfo["panel"])
+		if len(templates) == 0 || templates[0] == nil {
+			continue
+		}
+		name := cast.ToString(templates[0].(map[string]interface{})["name"])
+		for _, value := range cast.ToStringSlice(repeatInfo["values"]) {
+			names[strings.ReplaceAll(name, dashboardPanelRepeatPlaceholder, value)]++
+		}
+	}
+	return names
+}
+
+// dashboardPanelDefaultSizes are the width and height given by auto_layout to the panels not setting them
+var dashboardPanelDefaultSizes = map[string]v2.Layout{
+	"timechart":    {W: 12, H: 6},
+	"number":       {W: 6, H: 4},
+	"text":         {W: 6, H: 4},
+	"table":        {W: 24, H: 8},
+	"toplist":      {W: 12, H: 6},
+	"histogram":    {W: 12, H: 6},
+	"bar_chart":    {W: 12, H: 6},
+	"event_stream": {W: 12, H: 8},
+}
+
+// autoLayoutPanels sets the position and size of the panels in declaration order. With a row template,
+// each row is split in as many columns as its entry in the template, cycling through it; otherwise the panels
+// use their default size and wrap to a new row when they do not fit in the 24 columns.
+// The width and height are only kept when set in the configuration, as the state holds the previous layout.
+func autoLayoutPanels(panelsInfo []interface{}, rawPanels []cty.Value, autoLayout interface{}) {
+	var rowTemplate []int
+	if autoLayoutInfo, ok := autoLayout.(map[string]interface{}); ok {
+		rowTemplate = cast.ToIntSlice(autoLayoutInfo["row_template"])
+	}
+
+	x, y, rowHeight, row, column := 0, 0, 0, 0, 0
+	for i, panelItr := range panelsInfo {
+		panelInfo := panelItr.(map[string]interface{})
+		size := dashboardPanelDefaultSizes[panelInfo["type"].(string)]
+		if i < len(rawPanels) {
+			if width, ok := rawConfigInt(rawPanels[i], "width"); ok {
+				size.W = width
+			}
+			if height, ok := rawConfigInt(rawPanels[i], "height"); ok {
+				size.H = height
+			}
+		}
+
+		if len(rowTemplate) > 0 {
+			columns := rowTemplate[row%len(rowTemplate)]
+			size.W = 24 / columns
+			x = column * size.W
+			// the last column takes what is left when 24 is not a multiple of the number of columns
+			if column == columns-1 {
+				size.W = 24 - x
+			}
+		} else if x+size.W > 24 {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		panelInfo["pos_x"] = x
+		panelInfo["pos_y"] = y
+		panelInfo["width"] = size.W
+		panelInfo["height"] = size.H
+		if rowHeight < size.H {
+			rowHeight = size.H
+		}
+
+		if len(rowTemplate) > 0 {
+			column++
+			if column == rowTemplate[row%len(rowTemplate)] {
+				y, rowHeight, row, column = y+rowHeight, 0, row+1, 0
+			}
+		} else {
+			x += size.W
+		}
+	}
+}
+
+// rawConfigBlocks returns the blocks with the given name in the configuration, in declaration order
+func rawConfigBlocks(config cty.Value, name string) []cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	blocks := config.GetAttr(name)
+	if blocks.IsNull() || !blocks.IsKnown() {
+		return nil
+	}
+	return blocks.AsValueSlice()
+}
+
+// rawConfigInt returns the value of an integer attribute of a block, if it is set and known
+func rawConfigInt(block cty.Value, name string) (int, bool) {
+	if block.IsNull() || !block.IsKnown() {
+		return 0, false
+	}
+	value := block.GetAttr(name)
+	if value.IsNull() || !value.IsKnown() {
+		return 0, false
+	}
+	i, _ := value.AsBigFloat().Int64()
+	return int(i), true
 }
 
 func defaultLegendConfiguration() *v2.LegendConfiguration {
@@ -480,49 +1269,92 @@ func legendFromResourceData(data interface{}) *v2.LegendConfiguration {
 	}
 }
 
-func timechartPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
-	panel := &v2.Panels{
+func defaultAxesConfiguration() *v2.AxesConfiguration {
+	return &v2.AxesConfiguration{
+		Bottom: v2.Bottom{Enabled: true},
+		Left: v2.Left{
+			Enabled:        true,
+			DisplayName:    nil,
+			Unit:           "auto",
+			DisplayFormat:  "auto",
+			Decimals:       "",
+			MinValue:       0,
+			MaxValue:       "",
+			MinInputFormat: "ns",
+			MaxInputFormat: "ns",
+			Scale:          "linear",
+		},
+		Right: v2.Right{
+			Enabled:        true,
+			DisplayName:    nil,
+			Unit:           "auto",
+			DisplayFormat:  "auto",
+			Decimals:       "",
+			MinValue:       0,
+			MaxValue:       "",
+			MinInputFormat: "1",
+			MaxInputFormat: "1",
+			Scale:          "linear",
+		},
+	}
+}
+
+func newTimechartPanel(name, description string, legend *v2.LegendConfiguration) *v2.Panels {
+	return &v2.Panels{
 		ID:                     0,
-		Name:                   panelInfo["name"].(string),
-		Description:            panelInfo["description"].(string),
+		Name:                   name,
+		Description:            description,
 		Type:                   v2.PanelTypeTimechart,
 		ApplyScopeToAll:        false,
 		ApplySegmentationToAll: false,
-		AxesConfiguration: &v2.AxesConfiguration{
-			Bottom: v2.Bottom{Enabled: true},
-			Left: v2.Left{
-				Enabled:        true,
-				DisplayName:    nil,
-				Unit:           "auto",
-				DisplayFormat:  "auto",
-				Decimals:       "",
-				MinValue:       0,
-				MaxValue:       "",
-				MinInputFormat: "ns",
-				MaxInputFormat: "ns",
-				Scale:          "linear",
-			},
-			Right: v2.Right{
-				Enabled:        true,
-				DisplayName:    nil,
-				Unit:           "auto",
-				DisplayFormat:  "auto",
-				Decimals:       "",
-				MinValue:       0,
-				MaxValue:       "",
-				MinInputFormat: "1",
-				MaxInputFormat: "1",
-				Scale:          "linear",
-			},
+		AxesConfiguration:      defaultAxesConfiguration(),
+		LegendConfiguration:    legend,
+		MarkdownSource:         nil,
+		PanelTitleVisible:      false,
+		TextAutosized:          false,
+		TransparentBackground:  false,
+	}
+}
+
+func newNumberPanel(name, description string) *v2.Panels {
+	return &v2.Panels{
+		ID:                     0,
+		Name:                   name,
+		Description:            description,
+		Type:                   v2.PanelTypeNumber,
+		ApplyScopeToAll:        false,
+		ApplySegmentationToAll: false,
+		AxesConfiguration:      defaultAxesConfiguration(),
+		LegendConfiguration: &v2.LegendConfiguration{
+			Enabled:     true,
+			Position:    "right",
+			Layout:      "table",
+			ShowCurrent: true,
+			Width:       nil,
+			Height:      nil,
 		},
-		LegendConfiguration:   legendFromResourceData(panelInfo["legend"]),
 		MarkdownSource:        nil,
 		PanelTitleVisible:     false,
 		TextAutosized:         false,
 		TransparentBackground: false,
+		NumberThresholds: &v2.NumberThresholds{
+			Values: []v2.NumberThresholdValue{}, // These values must be not nil in case of type number
+			Base: v2.NumberThresholdBase{
+				Severity: "none",
+			},
+		},
 	}
+}
 
-	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+func timechartPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel := newTimechartPanel(panelInfo["name"].(string), panelInfo["description"].(string), legendFromResourceData(panelInfo["legend"]))
+
+	err := axesFromResourceData(panelInfo, panel.AxesConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
 	if err != nil {
 		return nil, err
 	}
@@ -535,83 +1367,272 @@ func timechartPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panel
 		return nil, fmt.Errorf("no query defined for timechart panel")
 	}
 
-	_, err = panel.AddQueries(queries...)
+	_, err = panel.AddQueries(queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	return panel, nil
+}
+
+func numberPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel := newNumberPanel(panelInfo["name"].(string), panelInfo["description"].(string))
+
+	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := queriesFromResourceData(panelInfo, panel)
+	if err != nil {
+		return nil, err
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no query defined for number panel")
+	}
+
+	_, err = panel.AddQueries(queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	// thresholds are compared with the value of the query, so they use its input format
+	inputFormat := ""
+	if panel.AdvancedQueries[0].Format.InputFormat != nil {
+		inputFormat = *panel.AdvancedQueries[0].Format.InputFormat
+	}
+	for _, thresholdItr := range panelOptionsList(panelInfo["thresholds"]) {
+		thresholdInfo := thresholdItr.(map[string]interface{})
+		panel.NumberThresholds.Values = append(panel.NumberThresholds.Values, v2.NumberThresholdValue{
+			Value:       thresholdInfo["value"].(float64),
+			Severity:    thresholdInfo["severity"].(string),
+			DisplayText: thresholdInfo["display_text"].(string),
+			InputFormat: inputFormat,
+		})
+	}
+
+	return panel, nil
+}
+
+func axesFromResourceData(panelInfo map[string]interface{}, axes *v2.AxesConfiguration) error {
+	if options := panelOptionsFromResourceData(panelInfo, "left_axis"); options != nil {
+		err := axisFromResourceData(options, &axes.Left)
+		if err != nil {
+			return fmt.Errorf("invalid left_axis: %w", err)
+		}
+	}
+
+	if options := panelOptionsFromResourceData(panelInfo, "right_axis"); options != nil {
+		// left and right axes have the same fields
+		left := v2.Left(axes.Right)
+		err := axisFromResourceData(options, &left)
+		if err != nil {
+			return fmt.Errorf("invalid right_axis: %w", err)
+		}
+		axes.Right = v2.Right(left)
+	}
+
+	if options := panelOptionsFromResourceData(panelInfo, "bottom_axis"); options != nil {
+		axes.Bottom.Enabled = options["enabled"].(bool)
+	}
+
+	return nil
+}
+
+func axisFromResourceData(axisInfo map[string]interface{}, axis *v2.Left) error {
+	axis.Enabled = axisInfo["enabled"].(bool)
+	axis.DisplayName = nil
+	if displayName := axisInfo["display_name"].(string); displayName != "" {
+		axis.DisplayName = displayName
+	}
+	axis.Unit = axisInfo["unit"].(string)
+	axis.DisplayFormat = axisInfo["display_format"].(string)
+	axis.MinValue = axisInfo["min_value"].(float64)
+	axis.Scale = axisInfo["scale"].(string)
+
+	axis.Decimals = ""
+	if decimals := axisInfo["decimals"].(string); decimals != "" {
+		d, err := strconv.Atoi(decimals)
+		if err != nil {
+			return fmt.Errorf("cannot convert decimals to a number: %w", err)
+		}
+		axis.Decimals = d
+	}
+
+	axis.MaxValue = ""
+	if maxValue := axisInfo["max_value"].(string); maxValue != "" {
+		m, err := strconv.ParseFloat(maxValue, 64)
+		if err != nil {
+			return fmt.Errorf("cannot convert max_value to a number: %w", err)
+		}
+		axis.MaxValue = m
+	}
+
+	return nil
+}
+
+func eventOverlayFromResourceData(data *schema.ResourceData) v2.EventDisplaySettings {
+	eventOverlay := data.Get("event_overlay").([]interface{})
+	if len(eventOverlay) == 0 || eventOverlay[0] == nil {
+		return v2.EventDisplaySettings{}
+	}
+
+	eventOverlayInfo := eventOverlay[0].(map[string]interface{})
+	return v2.EventDisplaySettings{
+		Enabled: eventOverlayInfo["enabled"].(bool),
+		QueryParams: v2.QueryParams{
+			Severities:    eventOverlayInfo["severities"].([]interface{}),
+			AlertStatuses: eventOverlayInfo["alert_statuses"].([]interface{}),
+			Categories:    eventOverlayInfo["categories"].([]interface{}),
+			Filter:        eventOverlayInfo["filter"].(string),
+			TeamScope:     eventOverlayInfo["team_scope"].(bool),
+		},
+	}
+}
+
+func textPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	content := panelInfo["content"].(string)
+	panel := &v2.Panels{
+		ID:                    0,
+		Name:                  panelInfo["name"].(string),
+		Description:           "",
+		Type:                  v2.PanelTypeText,
+		MarkdownSource:        &content,
+		PanelTitleVisible:     panelInfo["visible_title"].(bool),
+		TextAutosized:         panelInfo["autosize_text"].(bool),
+		TransparentBackground: panelInfo["transparent_background"].(bool),
+	}
+	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	return panel, nil
+}
+
+func newPanel(name, description string, panelType v2.PanelType) *v2.Panels {
+	return &v2.Panels{
+		ID:                     0,
+		Name:                   name,
+		Description:            description,
+		Type:                   panelType,
+		ApplyScopeToAll:        false,
+		ApplySegmentationToAll: false,
+		MarkdownSource:         nil,
+		PanelTitleVisible:      false,
+		TextAutosized:          false,
+		TransparentBackground:  false,
+	}
+}
+
+// queryPanelFromResourceData builds a panel of the given type with its layout and at least one query
+func queryPanelFromResourceData(panelInfo map[string]interface{}, panelType v2.PanelType) (*v2.Panels, error) {
+	panel := newPanel(panelInfo["name"].(string), panelInfo["description"].(string), panelType)
+
+	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := queriesFromResourceData(panelInfo, panel)
+	if err != nil {
+		return nil, err
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("no query defined for %s panel", panelInfo["type"])
+	}
+
+	_, err = panel.AddQueries(queries...)
+	if err != nil {
+		return nil, err
+	}
+
+	return panel, nil
+}
+
+// panelOptionsList returns the elements of a panel block, which can be either a set or a list
+func panelOptionsList(options interface{}) []interface{} {
+	var list []interface{}
+	switch o := options.(type) {
+	case *schema.Set:
+		list = o.List()
+	case []interface{}:
+		list = o
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return list
+}
+
+func panelOptionsFromResourceData(panelInfo map[string]interface{}, block string) map[string]interface{} {
+	options := panelOptionsList(panelInfo[block])
+	if options == nil {
+		return nil
+	}
+	return options[0].(map[string]interface{})
+}
+
+func tablePanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel, err := queryPanelFromResourceData(panelInfo, v2.PanelTypeTable)
+	if err != nil {
+		return nil, err
+	}
+
+	panel.TableConfiguration = &v2.TableConfiguration{Columns: []v2.TableColumn{}}
+	if options := panelOptionsFromResourceData(panelInfo, "table"); options != nil {
+		for _, columnItr := range options["column"].([]interface{}) {
+			columnInfo := columnItr.(map[string]interface{})
+			panel.TableConfiguration.Columns = append(panel.TableConfiguration.Columns, v2.TableColumn{
+				Key:         columnInfo["key"].(string),
+				DisplayName: columnInfo["display_name"].(string),
+				Visible:     columnInfo["visible"].(bool),
+			})
+		}
+	}
+
+	return panel, nil
+}
+
+func toplistPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel, err := queryPanelFromResourceData(panelInfo, v2.PanelTypeToplist)
 	if err != nil {
 		return nil, err
 	}
 
+	panel.ToplistConfiguration = defaultToplistConfiguration()
+	if options := panelOptionsFromResourceData(panelInfo, "toplist"); options != nil {
+		panel.ToplistConfiguration.SortDirection = options["sort_direction"].(string)
+		panel.ToplistConfiguration.Limit = options["limit"].(int)
+	}
+
 	return panel, nil
 }
 
-func numberPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
-	panel := &v2.Panels{
-		ID:                     0,
-		Name:                   panelInfo["name"].(string),
-		Description:            panelInfo["description"].(string),
-		Type:                   v2.PanelTypeNumber,
-		ApplyScopeToAll:        false,
-		ApplySegmentationToAll: false,
-		AxesConfiguration: &v2.AxesConfiguration{
-			Bottom: v2.Bottom{Enabled: true},
-			Left: v2.Left{
-				Enabled:        true,
-				DisplayName:    nil,
-				Unit:           "auto",
-				DisplayFormat:  "auto",
-				Decimals:       "",
-				MinValue:       0,
-				MaxValue:       "",
-				MinInputFormat: "ns",
-				MaxInputFormat: "ns",
-				Scale:          "linear",
-			},
-			Right: v2.Right{
-				Enabled:        true,
-				DisplayName:    nil,
-				Unit:           "auto",
-				DisplayFormat:  "auto",
-				Decimals:       "",
-				MinValue:       0,
-				MaxValue:       "",
-				MinInputFormat: "1",
-				MaxInputFormat: "1",
-				Scale:          "linear",
-			},
-		},
-		LegendConfiguration: &v2.LegendConfiguration{
-			Enabled:     true,
-			Position:    "right",
-			Layout:      "table",
-			ShowCurrent: true,
-			Width:       nil,
-			Height:      nil,
-		},
-		MarkdownSource:        nil,
-		PanelTitleVisible:     false,
-		TextAutosized:         false,
-		TransparentBackground: false,
-		NumberThresholds: &v2.NumberThresholds{
-			Values: []interface{}{}, // These values must be not nil in case of type number
-			Base: v2.NumberThresholdBase{
-				Severity: "none",
-			},
-		},
-	}
-
-	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
+func histogramPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel, err := queryPanelFromResourceData(panelInfo, v2.PanelTypeHistogram)
 	if err != nil {
 		return nil, err
 	}
 
-	queries, err := queriesFromResourceData(panelInfo, panel)
+	panel.HistogramConfiguration = defaultHistogramConfiguration()
+	if options := panelOptionsFromResourceData(panelInfo, "histogram"); options != nil {
+		panel.HistogramConfiguration.NumberOfBuckets = options["buckets"].(int)
+	}
+
+	return panel, nil
+}
+
+// barChartPanelFromResourceData builds a bar chart, which has the legend and the axes of a timechart
+func barChartPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	panel, err := queryPanelFromResourceData(panelInfo, v2.PanelTypeBarChart)
 	if err != nil {
 		return nil, err
 	}
-	if len(queries) == 0 {
-		return nil, fmt.Errorf("no query defined for number panel")
-	}
 
-	_, err = panel.AddQueries(queries...)
+	panel.LegendConfiguration = legendFromResourceData(panelInfo["legend"])
+	panel.AxesConfiguration = defaultAxesConfiguration()
+	err = axesFromResourceData(panelInfo, panel.AxesConfiguration)
 	if err != nil {
 		return nil, err
 	}
@@ -619,26 +1640,46 @@ func numberPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels,
 	return panel, nil
 }
 
-func textPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
-	content := panelInfo["content"].(string)
-	panel := &v2.Panels{
-		ID:                    0,
-		Name:                  panelInfo["name"].(string),
-		Description:           "",
-		Type:                  v2.PanelTypeText,
-		MarkdownSource:        &content,
-		PanelTitleVisible:     panelInfo["visible_title"].(bool),
-		TextAutosized:         panelInfo["autosize_text"].(bool),
-		TransparentBackground: panelInfo["transparent_background"].(bool),
+func eventStreamPanelFromResourceData(panelInfo map[string]interface{}) (*v2.Panels, error) {
+	if query, ok := panelInfo["query"].(*schema.Set); ok && query.Len() > 0 {
+		return nil, fmt.Errorf("no query can be defined for event_stream panel")
 	}
+
+	panel := newPanel(panelInfo["name"].(string), panelInfo["description"].(string), v2.PanelTypeEventStream)
 	_, err := panel.WithLayout(panelInfo["pos_x"].(int), panelInfo["pos_y"].(int), panelInfo["width"].(int), panelInfo["height"].(int))
 	if err != nil {
 		return nil, err
 	}
 
+	panel.EventsQueryParams = &v2.QueryParams{
+		Severities:    []interface{}{},
+		AlertStatuses: []interface{}{},
+		Categories:    []interface{}{},
+	}
+	if options := panelOptionsFromResourceData(panelInfo, "event_stream"); options != nil {
+		panel.EventsQueryParams.Filter = options["filter"].(string)
+		panel.EventsQueryParams.Severities = options["severities"].([]interface{})
+		panel.EventsQueryParams.AlertStatuses = options["alert_statuses"].([]interface{})
+		panel.EventsQueryParams.Categories = options["categories"].([]interface{})
+		panel.EventsQueryParams.TeamScope = options["team_scope"].(bool)
+	}
+
 	return panel, nil
 }
 
+func defaultToplistConfiguration() *v2.ToplistConfiguration {
+	return &v2.ToplistConfiguration{
+		SortDirection: "desc",
+		Limit:         10,
+	}
+}
+
+func defaultHistogramConfiguration() *v2.HistogramConfiguration {
+	return &v2.HistogramConfiguration{
+		NumberOfBuckets: 10,
+	}
+}
+
 func formatFromResourceData(queryInfo map[string]interface{}) *v2.Format {
 	formatData, ok := queryInfo["format"]
 	if !ok {
@@ -731,9 +1772,20 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 	_ = data.Set("public", dashboard.Public)
 	_ = data.Set("public_token", dashboard.PublicToken)
 
+	// the panels generated by panel_repeat are only described by their template, they are told apart by their name
+	generated := repeatedPanelNames(data.Get("panel_repeat").([]interface{}))
+	var configuredPanels []*v2.Panels
+	for _, panel := range dashboard.Panels {
+		if generated[panel.Name] > 0 {
+			generated[panel.Name]--
+			continue
+		}
+		configuredPanels = append(configuredPanels, panel)
+	}
+
 	var panels []map[string]interface{}
-	for i, panel := range dashboard.Panels {
-		panelsData := data.Get("panel").(*schema.Set).List()
+	for i, panel := range configuredPanels {
+		panelsData := data.Get("panel").([]interface{})
 		panelData := map[string]interface{}{}
 		if len(panelsData) > i {
 			panelData = panelsData[i].(map[string]interface{})
@@ -747,8 +1799,21 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 	}
 	_ = data.Set("panel", panels)
 
+	// the variables are told apart from the scopes by the variable blocks of the configuration, so an imported
+	// dashboard has all of them as scopes
+	configuredVariables := map[string]map[string]interface{}{}
+	for _, variableItr := range data.Get("variable").([]interface{}) {
+		variableInfo := variableItr.(map[string]interface{})
+		configuredVariables[variableInfo["name"].(string)] = variableInfo
+	}
+
 	var scopes []map[string]interface{}
+	var variables []map[string]interface{}
 	for _, scope := range dashboard.ScopeExpressionList {
+		if configured, ok := configuredVariables[scope.DisplayName]; ok && scope.IsVariable {
+			variables = append(variables, variableToResourceData(scope, configured))
+			continue
+		}
 		dScope, err := scopeToResourceData(scope)
 		if err != nil {
 			return err
@@ -756,6 +1821,7 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 		scopes = append(scopes, dScope)
 	}
 	_ = data.Set("scope", scopes)
+	_ = data.Set("variable", variables)
 	_ = data.Set("version", dashboard.Version)
 
 	var shares []map[string]interface{}
@@ -767,10 +1833,56 @@ func dashboardToResourceData(dashboard *v2.Dashboard, data *schema.ResourceData)
 		shares = append(shares, dShare)
 	}
 	_ = data.Set("share", shares)
+	_ = data.Set("team_sharing", teamSharingToResourceData(dashboard.TeamSharingOptions))
+	// the tenants without dashboard folders never return the folder, which is then kept as configured
+	if dashboard.Folder != "" {
+		_ = data.Set("folder", dashboard.Folder)
+	}
+
+	_ = data.Set("event_overlay", eventOverlayToResourceData(dashboard.EventDisplaySettings, data))
 
 	return nil
 }
 
+func eventOverlayToResourceData(settings v2.EventDisplaySettings, data *schema.ResourceData) []map[string]interface{} {
+	params := settings.QueryParams
+	// the overlay is only set in the resource data if it is configured, to avoid drifts with the API defaults
+	if len(data.Get("event_overlay").([]interface{})) == 0 && !settings.Enabled &&
+		params.Filter == "" && len(params.Severities) == 0 && len(params.AlertStatuses) == 0 && len(params.Categories) == 0 && !params.TeamScope {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"enabled":        settings.Enabled,
+		"filter":         params.Filter,
+		"severities":     params.Severities,
+		"alert_statuses": params.AlertStatuses,
+		"categories":     params.Categories,
+		"team_scope":     params.TeamScope,
+	}}
+}
+
+func teamSharingToResourceData(options v2.TeamSharingOptions) []map[string]interface{} {
+	if options.Type == "" || options.Type == v2.TeamSharingTypeNone {
+		return nil
+	}
+
+	// the selected teams are returned either as IDs or as objects with an ID
+	var teams []int
+	for _, team := range options.SelectedTeams {
+		if teamInfo, ok := team.(map[string]interface{}); ok {
+			team = teamInfo["id"]
+		}
+		teams = append(teams, cast.ToInt(team))
+	}
+
+	return []map[string]interface{}{{
+		"type":  options.Type,
+		"role":  options.UserTeamsRole,
+		"teams": teams,
+	}}
+}
+
 func shareToResourceData(share *v2.SharingOptions) (map[string]interface{}, error) {
 	res := map[string]interface{}{
 		"role": share.Role,
@@ -835,6 +1947,211 @@ func scopeFromResourceData(data *schema.ResourceData) ([]*v2.ScopeExpressionList
 	return scopes, nil
 }
 
+func variablesFromResourceData(data *schema.ResourceData) ([]*v2.ScopeExpressionList, error) {
+	variables := []*v2.ScopeExpressionList{}
+	for _, variableItr := range data.Get("variable").([]interface{}) {
+		variableInfo := variableItr.(map[string]interface{})
+
+		multiSelect := variableInfo["multi_select"].(bool)
+		variable := &v2.ScopeExpressionList{
+			Operand:       variableInfo["label"].(string),
+			Operator:      "in",
+			DisplayName:   variableInfo["name"].(string),
+			Value:         cast.ToStringSlice(variableInfo["allowed_values"]),
+			IsVariable:    true,
+			Title:         variableInfo["display_name"].(string),
+			DefaultValues: cast.ToStringSlice(variableInfo["default_values"]),
+			MultiSelect:   &multiSelect,
+		}
+
+		if !multiSelect && len(variable.DefaultValues) > 1 {
+			return nil, fmt.Errorf("variable %s can only have one default value if multi_select is false", variable.DisplayName)
+		}
+		if len(variable.Value) > 0 {
+			for _, defaultValue := range variable.DefaultValues {
+				if !contains(variable.Value, defaultValue) {
+					return nil, fmt.Errorf("the default value %q of variable %s is not one of its allowed values", defaultValue, variable.DisplayName)
+				}
+			}
+		}
+
+		variables = append(variables, variable)
+	}
+	return variables, nil
+}
+
+// variableToResourceData keeps the configured display name, default values and multi selection when Sysdig
+// does not return them
+func variableToResourceData(scope *v2.ScopeExpressionList, configured map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":           scope.DisplayName,
+		"label":          scope.Operand,
+		"display_name":   configured["display_name"],
+		"allowed_values": scope.Value,
+		"default_values": configured["default_values"],
+		"multi_select":   configured["multi_select"],
+	}
+	if scope.Title != "" {
+		res["display_name"] = scope.Title
+	}
+	if scope.DefaultValues != nil {
+		res["default_values"] = scope.DefaultValues
+	}
+	if scope.MultiSelect != nil {
+		res["multi_select"] = *scope.MultiSelect
+	}
+	return res
+}
+
+var promqlVariableRegexp = regexp.MustCompile(`\$\{?([a-zA-Z_][a-zA-Z0-9_]*)\}?`)
+
+// validateDashboardLayout checks at plan time that the panels either all have a position, without overlapping,
+// or leave it to auto_layout
+func validateDashboardLayout(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	config := diff.GetRawConfig()
+	autoLayout := rawConfigBlocks(config, "auto_layout")
+	rowTemplate := len(autoLayout) > 0 && !autoLayout[0].GetAttr("row_template").IsNull()
+
+	var placed []v2.Layout
+	var placedNames []string
+	for _, panel := range rawConfigBlocks(config, "panel") {
+		if panel.IsNull() || !panel.IsKnown() {
+			continue
+		}
+		name := panel.GetAttr("name")
+		panelName := ""
+		if !name.IsNull() && name.IsKnown() {
+			panelName = name.AsString()
+		}
+
+		if len(autoLayout) > 0 {
+			if !panel.GetAttr("pos_x").IsNull() || !panel.GetAttr("pos_y").IsNull() {
+				return fmt.Errorf("pos_x and pos_y cannot be set on panel %q when auto_layout is enabled", panelName)
+			}
+			if rowTemplate && !panel.GetAttr("width").IsNull() {
+				return fmt.Errorf("width cannot be set on panel %q when the auto_layout row_template sets the width of the panels", panelName)
+			}
+			continue
+		}
+
+		for _, attribute := range []string{"pos_x", "pos_y", "width", "height"} {
+			if panel.GetAttr(attribute).IsNull() {
+				return fmt.Errorf("%s must be set on panel %q unless auto_layout is enabled", attribute, panelName)
+			}
+		}
+
+		x, xOk := rawConfigInt(panel, "pos_x")
+		y, yOk := rawConfigInt(panel, "pos_y")
+		w, wOk := rawConfigInt(panel, "width")
+		h, hOk := rawConfigInt(panel, "height")
+		if !xOk || !yOk || !wOk || !hOk {
+			continue
+		}
+		if x+w > 24 {
+			return fmt.Errorf("panel %q does not fit in the 24 columns of the dashboard: pos_x + width must be lower or equal to 24", panelName)
+		}
+		for j, other := range placed {
+			if x < other.X+other.W && other.X < x+w && y < other.Y+other.H && other.Y < y+h {
+				return fmt.Errorf("panels %q and %q overlap", placedNames[j], panelName)
+			}
+		}
+		placed = append(placed, v2.Layout{X: x, Y: y, W: w, H: h})
+		placedNames = append(placedNames, panelName)
+	}
+
+	return nil
+}
+
+// planDashboardPanels places the panels of an auto_layout dashboard at plan time, so that the panels moved by
+// the insertion or removal of another one show in the plan. As the panels are computed, removing all of them in
+// favour of panel_repeat blocks is planned as well.
+func planDashboardPanels(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("panel").IsWhollyKnown() {
+		return nil
+	}
+	if len(rawConfigBlocks(config, "panel")) == 0 {
+		if len(diff.Get("panel").([]interface{})) == 0 {
+			return nil
+		}
+		return diff.SetNew("panel", []interface{}{})
+	}
+
+	autoLayout := diff.Get("auto_layout").([]interface{})
+	if len(autoLayout) == 0 || !config.GetAttr("auto_layout").IsWhollyKnown() {
+		return nil
+	}
+
+	panels := diff.Get("panel").([]interface{})
+	autoLayoutPanels(panels, rawConfigBlocks(config, "panel"), autoLayout[0])
+	return diff.SetNew("panel", panels)
+}
+
+// validateDashboardPanelRepeats checks that the panels generated by panel_repeat, which are told apart by their name,
+// do not have the name of a panel
+func validateDashboardPanelRepeats(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	generated := repeatedPanelNames(diff.Get("panel_repeat").([]interface{}))
+	for _, panelItr := range diff.Get("panel").([]interface{}) {
+		name := cast.ToString(panelItr.(map[string]interface{})["name"])
+		if generated[name] > 0 {
+			return fmt.Errorf("panel %q has the name of a panel generated by panel_repeat", name)
+		}
+	}
+	return nil
+}
+
+// validateDashboardVariables checks that the PromQL queries of the panels only reference declared variables,
+// besides the predefined ones such as $__interval and $__range
+func validateDashboardVariables(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	declared := map[string]bool{}
+	for _, scopeItr := range diff.Get("scope").(*schema.Set).List() {
+		if variable := cast.ToString(scopeItr.(map[string]interface{})["variable"]); variable != "" {
+			declared[variable] = true
+		}
+	}
+	for _, variableItr := range diff.Get("variable").([]interface{}) {
+		variable, ok := variableItr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := cast.ToString(variable["name"])
+		if declared[name] {
+			return fmt.Errorf("variable %s is declared more than once", name)
+		}
+		declared[name] = true
+	}
+
+	panelsInfo := diff.Get("panel").([]interface{})
+	for _, repeatItr := range diff.Get("panel_repeat").([]interface{}) {
+		if repeatInfo, ok := repeatItr.(map[string]interface{}); ok {
+			panelsInfo = append(panelsInfo, cast.ToSlice(repeatInfo["panel"])...)
+		}
+	}
+
+	for _, panelItr := range panelsInfo {
+		panelInfo, ok := panelItr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		queries, ok := panelInfo["query"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, queryItr := range queries.List() {
+			promql := cast.ToString(queryItr.(map[string]interface{})["promql"])
+			for _, match := range promqlVariableRegexp.FindAllStringSubmatch(promql, -1) {
+				name := match[1]
+				if strings.HasPrefix(name, "__") || declared[name] {
+					continue
+				}
+				return fmt.Errorf("the query %q of panel %q references the undeclared variable $%s", promql, panelInfo["name"], name)
+			}
+		}
+	}
+
+	return nil
+}
+
 func panelToResourceData(panel *v2.Panels, layout []*v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
 	var panelLayout *v2.Layout
 
@@ -854,6 +2171,16 @@ func panelToResourceData(panel *v2.Panels, layout []*v2.Layout, panelData map[st
 		return numberPanelToResourceData(panel, panelLayout, panelData)
 	case v2.PanelTypeText:
 		return textPanelToResourceData(panel, panelLayout)
+	case v2.PanelTypeTable:
+		return tablePanelToResourceData(panel, panelLayout, panelData)
+	case v2.PanelTypeToplist:
+		return toplistPanelToResourceData(panel, panelLayout, panelData)
+	case v2.PanelTypeHistogram:
+		return histogramPanelToResourceData(panel, panelLayout, panelData)
+	case v2.PanelTypeBarChart:
+		return barChartPanelToResourceData(panel, panelLayout, panelData)
+	case v2.PanelTypeEventStream:
+		return eventStreamPanelToResourceData(panel, panelLayout, panelData)
 	default:
 		return nil, fmt.Errorf("unsupported panel type %s", panel.Type)
 	}
@@ -875,9 +2202,56 @@ func timechartPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, pane
 		"type":        "timechart",
 		"query":       queries,
 		"legend":      legendConfigurationToResourceData(panel.LegendConfiguration, panelData),
+		"left_axis":   leftAxisToResourceData(panel.AxesConfiguration, panelData),
+		"right_axis":  rightAxisToResourceData(panel.AxesConfiguration, panelData),
+		"bottom_axis": bottomAxisToResourceData(panel.AxesConfiguration, panelData),
 	}, nil
 }
 
+// leftAxisToResourceData, as for the legend and the other axes, only sets the axis in the resource data
+// if it is in the user configuration or it is different from the default one, to avoid drifts
+func leftAxisToResourceData(axes *v2.AxesConfiguration, panelData map[string]interface{}) []map[string]interface{} {
+	if axes == nil {
+		return nil
+	}
+	axis := axisToResourceData(axes.Left)
+	if panelOptionsList(panelData["left_axis"]) == nil && reflect.DeepEqual(axis, axisToResourceData(defaultAxesConfiguration().Left)) {
+		return nil
+	}
+	return []map[string]interface{}{axis}
+}
+
+func rightAxisToResourceData(axes *v2.AxesConfiguration, panelData map[string]interface{}) []map[string]interface{} {
+	if axes == nil {
+		return nil
+	}
+	axis := axisToResourceData(v2.Left(axes.Right))
+	if panelOptionsList(panelData["right_axis"]) == nil && reflect.DeepEqual(axis, axisToResourceData(v2.Left(defaultAxesConfiguration().Right))) {
+		return nil
+	}
+	return []map[string]interface{}{axis}
+}
+
+func bottomAxisToResourceData(axes *v2.AxesConfiguration, panelData map[string]interface{}) []map[string]interface{} {
+	if axes == nil || (panelOptionsList(panelData["bottom_axis"]) == nil && axes.Bottom == defaultAxesConfiguration().Bottom) {
+		return nil
+	}
+	return []map[string]interface{}{{"enabled": axes.Bottom.Enabled}}
+}
+
+func axisToResourceData(axis v2.Left) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":        axis.Enabled,
+		"display_name":   cast.ToString(axis.DisplayName),
+		"unit":           axis.Unit,
+		"display_format": axis.DisplayFormat,
+		"decimals":       cast.ToString(axis.Decimals),
+		"min_value":      axis.MinValue,
+		"max_value":      cast.ToString(axis.MaxValue),
+		"scale":          axis.Scale,
+	}
+}
+
 func legendConfigurationToResourceData(legend *v2.LegendConfiguration, panelData map[string]interface{}) []map[string]interface{} {
 
 	legendData := panelData["legend"]
@@ -912,9 +2286,26 @@ func numberPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelDa
 		"description": panel.Description,
 		"type":        "number",
 		"query":       queries,
+		"thresholds":  numberThresholdsToResourceData(panel.NumberThresholds),
 	}, nil
 }
 
+func numberThresholdsToResourceData(thresholds *v2.NumberThresholds) []map[string]interface{} {
+	if thresholds == nil {
+		return nil
+	}
+
+	var res []map[string]interface{}
+	for _, threshold := range thresholds.Values {
+		res = append(res, map[string]interface{}{
+			"value":        threshold.Value,
+			"severity":     threshold.Severity,
+			"display_text": threshold.DisplayText,
+		})
+	}
+	return res
+}
+
 func textPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout) (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"pos_x":                  panelLayout.X,
@@ -930,6 +2321,121 @@ func textPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout) (map[stri
 	}, nil
 }
 
+func queryPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}, panelType string) (map[string]interface{}, error) {
+	queries, err := queriesToResourceData(panel.AdvancedQueries, panelData)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"pos_x":       panelLayout.X,
+		"pos_y":       panelLayout.Y,
+		"width":       panelLayout.W,
+		"height":      panelLayout.H,
+		"name":        panel.Name,
+		"description": panel.Description,
+		"type":        panelType,
+		"query":       queries,
+	}, nil
+}
+
+func tablePanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
+	res, err := queryPanelToResourceData(panel, panelLayout, panelData, "table")
+	if err != nil {
+		return nil, err
+	}
+
+	if panel.TableConfiguration != nil && len(panel.TableConfiguration.Columns) > 0 {
+		var columns []map[string]interface{}
+		for _, column := range panel.TableConfiguration.Columns {
+			columns = append(columns, map[string]interface{}{
+				"key":          column.Key,
+				"display_name": column.DisplayName,
+				"visible":      column.Visible,
+			})
+		}
+		res["table"] = []map[string]interface{}{{"column": columns}}
+	}
+
+	return res, nil
+}
+
+func toplistPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
+	res, err := queryPanelToResourceData(panel, panelLayout, panelData, "toplist")
+	if err != nil {
+		return nil, err
+	}
+
+	// as for the legend, the default configuration is not set to avoid drifts when the block is not in the user configuration
+	toplistData, _ := panelData["toplist"].(*schema.Set)
+	if panel.ToplistConfiguration != nil && (toplistData != nil && toplistData.Len() > 0 || *panel.ToplistConfiguration != *defaultToplistConfiguration()) {
+		res["toplist"] = []map[string]interface{}{{
+			"sort_direction": panel.ToplistConfiguration.SortDirection,
+			"limit":          panel.ToplistConfiguration.Limit,
+		}}
+	}
+
+	return res, nil
+}
+
+func histogramPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
+	res, err := queryPanelToResourceData(panel, panelLayout, panelData, "histogram")
+	if err != nil {
+		return nil, err
+	}
+
+	histogramData, _ := panelData["histogram"].(*schema.Set)
+	if panel.HistogramConfiguration != nil && (histogramData != nil && histogramData.Len() > 0 || *panel.HistogramConfiguration != *defaultHistogramConfiguration()) {
+		res["histogram"] = []map[string]interface{}{{
+			"buckets": panel.HistogramConfiguration.NumberOfBuckets,
+		}}
+	}
+
+	return res, nil
+}
+
+func barChartPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
+	res, err := queryPanelToResourceData(panel, panelLayout, panelData, "bar_chart")
+	if err != nil {
+		return nil, err
+	}
+
+	if panel.LegendConfiguration != nil {
+		res["legend"] = legendConfigurationToResourceData(panel.LegendConfiguration, panelData)
+	}
+	res["left_axis"] = leftAxisToResourceData(panel.AxesConfiguration, panelData)
+	res["right_axis"] = rightAxisToResourceData(panel.AxesConfiguration, panelData)
+	res["bottom_axis"] = bottomAxisToResourceData(panel.AxesConfiguration, panelData)
+
+	return res, nil
+}
+
+func eventStreamPanelToResourceData(panel *v2.Panels, panelLayout *v2.Layout, panelData map[string]interface{}) (map[string]interface{}, error) {
+	res := map[string]interface{}{
+		"pos_x":       panelLayout.X,
+		"pos_y":       panelLayout.Y,
+		"width":       panelLayout.W,
+		"height":      panelLayout.H,
+		"name":        panel.Name,
+		"description": panel.Description,
+		"type":        "event_stream",
+	}
+
+	eventStreamData, _ := panelData["event_stream"].(*schema.Set)
+	params := panel.EventsQueryParams
+	if params != nil && (eventStreamData != nil && eventStreamData.Len() > 0 || params.Filter != "" || len(params.Severities) > 0 || len(params.AlertStatuses) > 0 || len(params.Categories) > 0 || params.TeamScope) {
+		res["event_stream"] = []map[string]interface{}{{
+			"filter":         params.Filter,
+			"severities":     params.Severities,
+			"alert_statuses": params.AlertStatuses,
+			"categories":     params.Categories,
+			"team_scope":     params.TeamScope,
+		}}
+	}
+
+	return res, nil
+}
+
 func queriesToResourceData(advancedQueries []*v2.AdvancedQueries, panelsData map[string]interface{}) ([]map[string]interface{}, error) {
 	var queries []map[string]interface{}
 	for queryIndex, query := range advancedQueries {
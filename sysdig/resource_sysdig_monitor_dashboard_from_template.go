@@ -0,0 +1,339 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSysdigMonitorDashboardFromTemplate() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	// scope and sharing are configured as in sysdig_monitor_dashboard, the scope defaulting to the one of the template
+	dashboardSchema := resourceSysdigMonitorDashboard().Schema
+	scope := *dashboardSchema["scope"]
+	scope.Computed = true
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorDashboardFromTemplateCreate,
+		UpdateContext: resourceSysdigMonitorDashboardFromTemplateUpdate,
+		ReadContext:   resourceSysdigMonitorDashboardFromTemplateRead,
+		DeleteContext: resourceSysdigMonitorDashboardFromTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSysdigMonitorDashboardFromTemplateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			_, err := teamSharingFromResourceData(diff.Get("team_sharing").([]interface{}))
+			if err != nil {
+				return err
+			}
+			return planDashboardTemplateVersion(ctx, diff, i)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"latest_template_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"scope":        &scope,
+			"share":        dashboardSchema["share"],
+			"team_sharing": dashboardSchema["team_sharing"],
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSysdigMonitorDashboardFromTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := getLatestDashboardTemplate(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard := template.Dashboard
+	dashboard.ID = 0
+	dashboard.Version = 0
+	dashboard.PublicToken = ""
+	err = dashboardTemplateOverridesFromResourceData(d, dashboard, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	created, err := client.CreateDashboard(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(created.ID))
+	_ = d.Set("template_version", template.Version)
+	_ = d.Set("latest_template_version", template.Version)
+
+	return resourceSysdigMonitorDashboardFromTemplateRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardFromTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := client.GetDashboard(ctx, id)
+	if err != nil {
+		if err == v2.DashboardNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("version", dashboard.Version)
+
+	var scopes []map[string]interface{}
+	for _, scope := range dashboard.ScopeExpressionList {
+		dScope, err := scopeToResourceData(scope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		scopes = append(scopes, dScope)
+	}
+	_ = d.Set("scope", scopes)
+
+	var shares []map[string]interface{}
+	for _, share := range dashboard.SharingSettings {
+		dShare, err := shareToResourceData(share)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		shares = append(shares, dShare)
+	}
+	_ = d.Set("share", shares)
+	_ = d.Set("team_sharing", teamSharingToResourceData(dashboard.TeamSharingOptions))
+
+	// the dashboard is still read when its template is not available, e.g. once it has been removed
+	template, err := client.GetDashboardTemplate(ctx, d.Get("template_id").(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "dashboard template not available",
+			Detail:   fmt.Sprintf("the template %s of the dashboard cannot be retrieved: %s", d.Get("template_id").(string), err),
+		}}
+	}
+	// the version a dashboard was cloned from is unknown when it is imported, latest_template_version is otherwise
+	// planned by planDashboardTemplateVersion so that the updates of the template show in the plan
+	if d.Get("template_version").(string) == "" {
+		_ = d.Set("template_version", template.Version)
+	}
+	if d.Get("latest_template_version").(string) == "" {
+		_ = d.Set("latest_template_version", template.Version)
+	}
+
+	// upstream updates are only applied when template_version is changed, so that teams can opt into them
+	if templateVersion := d.Get("template_version").(string); templateVersion != template.Version {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "dashboard template updated",
+			Detail: fmt.Sprintf("the template %s has been updated from version %s to %s, set template_version to %q to update the dashboard",
+				template.ID, templateVersion, template.Version, template.Version),
+		}}
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorDashboardFromTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// a new version of the template alone does not change the dashboard
+	if !d.HasChangeExcept("latest_template_version") {
+		return resourceSysdigMonitorDashboardFromTemplateRead(ctx, d, meta)
+	}
+
+	current, err := client.GetDashboard(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard := current
+	if d.HasChange("template_version") {
+		template, err := getLatestDashboardTemplate(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		dashboard = template.Dashboard
+		dashboard.ID = current.ID
+		dashboard.Version = current.Version
+		dashboard.PublicToken = current.PublicToken
+		dashboard.Public = current.Public
+	}
+
+	err = dashboardTemplateOverridesFromResourceData(d, dashboard, current)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateDashboard(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorDashboardFromTemplateRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardFromTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteDashboard(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceSysdigMonitorDashboardFromTemplateImport imports a dashboard as <dashboard id>:<template id>,
+// as the template cannot be found from the dashboard
+func resourceSysdigMonitorDashboardFromTemplateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, templateID, found := strings.Cut(d.Id(), ":")
+	if !found || templateID == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <dashboard id>:<template id>", d.Id())
+	}
+	if _, err := strconv.Atoi(id); err != nil {
+		return nil, fmt.Errorf("invalid dashboard ID %q: %w", id, err)
+	}
+
+	d.SetId(id)
+	_ = d.Set("template_id", templateID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// planDashboardTemplateVersion plans the version of the template as latest_template_version, so that a new version
+// of the template shows as a change, the dashboard being only updated to it when template_version is changed
+func planDashboardTemplateVersion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return err
+	}
+	// the template not being available is reported by the read
+	template, err := client.GetDashboardTemplate(ctx, diff.Get("template_id").(string))
+	if err != nil {
+		return nil
+	}
+
+	if template.Version != diff.Get("latest_template_version").(string) {
+		return diff.SetNew("latest_template_version", template.Version)
+	}
+	return nil
+}
+
+// getLatestDashboardTemplate returns the template, which can only be used in its latest version
+func getLatestDashboardTemplate(ctx context.Context, client v2.DashboardInterface, d *schema.ResourceData) (*v2.DashboardTemplate, error) {
+	template, err := client.GetDashboardTemplate(ctx, d.Get("template_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if version := d.Get("template_version").(string); version != "" && version != template.Version {
+		return nil, fmt.Errorf("the template %s is at version %s, only the latest version of a template can be used, not %s", template.ID, template.Version, version)
+	}
+	return template, nil
+}
+
+// dashboardTemplateOverridesFromResourceData applies the name, scope and sharing configured on top of the template,
+// the scope of the template is kept unless a scope is configured
+func dashboardTemplateOverridesFromResourceData(d *schema.ResourceData, dashboard *v2.Dashboard, current *v2.Dashboard) error {
+	if name := d.Get("name").(string); name != "" {
+		dashboard.Name = name
+	}
+
+	if len(rawConfigBlocks(d.GetRawConfig(), "scope")) > 0 {
+		scopes, err := scopeFromResourceData(d)
+		if err != nil {
+			return err
+		}
+		// the descriptors of the scope labels are resolved by Sysdig, keep them instead of sending them empty
+		existingScopes := dashboard.ScopeExpressionList
+		if current != nil {
+			existingScopes = append(existingScopes[:len(existingScopes):len(existingScopes)], current.ScopeExpressionList...)
+		}
+		keepScopeDescriptors(scopes, existingScopes)
+		dashboard.ScopeExpressionList = scopes
+	}
+
+	shares, err := sharingFromResourceData(d)
+	if err != nil {
+		return err
+	}
+	dashboard.SharingSettings = shares
+
+	dashboard.TeamSharingOptions, err = teamSharingFromResourceData(d.Get("team_sharing").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorDashboardFromTemplate(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardFromTemplate(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sysdig_monitor_dashboard_from_template.dashboard", "template_version"),
+					resource.TestCheckResourceAttrPair(
+						"sysdig_monitor_dashboard_from_template.dashboard", "template_version",
+						"sysdig_monitor_dashboard_from_template.dashboard", "latest_template_version",
+					),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard_from_template.dashboard", "scope.#", "1"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard_from_template.dashboard", "team_sharing.0.type", "ALL"),
+				),
+			},
+			{
+				ResourceName:            "sysdig_monitor_dashboard_from_template.dashboard",
+				ImportState:             true,
+				ImportStateIdFunc:       dashboardFromTemplateImportID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template_version"},
+			},
+			{
+				Config:      dashboardFromTemplateWithVersion(rText(), "0"),
+				ExpectError: regexp.MustCompile(`only the latest version of a template can be used`),
+			},
+		},
+	})
+}
+
+func dashboardFromTemplateImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["sysdig_monitor_dashboard_from_template.dashboard"]
+	if !ok {
+		return "", fmt.Errorf("dashboard not found in state")
+	}
+	return fmt.Sprintf("%s:%s", rs.Primary.ID, rs.Primary.Attributes["template_id"]), nil
+}
+
+func dashboardFromTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard_from_template" "dashboard" {
+	template_id = "kubernetes-cluster-overview"
+	name = "TERRAFORM TEST - TEMPLATE %s"
+
+	scope {
+		metric = "kube_cluster_name"
+		comparator = "in"
+		value = ["prod"]
+	}
+
+	team_sharing {
+		type = "ALL"
+	}
+}
+`, name)
+}
+
+func dashboardFromTemplateWithVersion(name, version string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard_from_template" "versioned" {
+	template_id = "kubernetes-cluster-overview"
+	template_version = "%s"
+	name = "TERRAFORM TEST - TEMPLATE %s"
+}
+`, version, name)
+}
@@ -0,0 +1,296 @@
+package sysdig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dashboardJSONServerManagedFields are set by Sysdig and would otherwise cause a diff on every plan
+var dashboardJSONServerManagedFields = []string{
+	"id",
+	"version",
+	"createdOn",
+	"modifiedOn",
+	"createdOnDate",
+	"modifiedOnDate",
+	"username",
+	"customerId",
+	"teamId",
+}
+
+func resourceSysdigMonitorDashboardJSON() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorDashboardJSONCreate,
+		UpdateContext: resourceSysdigMonitorDashboardJSONUpdate,
+		ReadContext:   resourceSysdigMonitorDashboardJSONRead,
+		DeleteContext: resourceSysdigMonitorDashboardJSONDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDashboardJSON,
+				DiffSuppressFunc: suppressEquivalentDashboardJSON,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSysdigMonitorDashboardJSONCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := normalizeDashboardJSON(d.Get("dashboard_json").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	created, err := client.CreateDashboardJSON(ctx, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, ok := created["id"].(float64)
+	if !ok {
+		return diag.Errorf("the created dashboard has no id")
+	}
+	d.SetId(strconv.Itoa(int(id)))
+
+	return resourceSysdigMonitorDashboardJSONRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardJSONRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := client.GetDashboardJSON(ctx, id)
+	if err != nil {
+		if err == v2.DashboardNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if version, ok := dashboard["version"].(float64); ok {
+		_ = d.Set("version", int(version))
+	}
+
+	normalizeDashboardJSONObject(dashboard)
+	// the fields Sysdig adds with their default value are only kept when they were configured, the dashboard is
+	// kept as returned when imported
+	var result interface{} = dashboard
+	if configured, err := normalizeDashboardJSON(d.Get("dashboard_json").(string)); err == nil {
+		result = dashboardJSONProject(dashboard, configured)
+	}
+	dashboardJSON, err := json.Marshal(result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("dashboard_json", string(dashboardJSON))
+
+	return nil
+}
+
+func resourceSysdigMonitorDashboardJSONUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboard, err := normalizeDashboardJSON(d.Get("dashboard_json").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dashboard["id"] = id
+	dashboard["version"] = d.Get("version").(int)
+
+	_, err = client.UpdateDashboardJSON(ctx, id, dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorDashboardJSONRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorDashboardJSONDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorDashboardClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteDashboard(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func validateDashboardJSON(i interface{}, k string) (warnings []string, errs []error) {
+	value, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := normalizeDashboardJSON(value); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", k, err))
+	}
+	return
+}
+
+// suppressEquivalentDashboardJSON ignores formatting and server managed fields, the fields Sysdig adds with their
+// default value being removed from the state on read
+func suppressEquivalentDashboardJSON(k, old, new string, d *schema.ResourceData) bool {
+	oldDashboard, err := normalizeDashboardJSON(old)
+	if err != nil {
+		return false
+	}
+
+	newDashboard, err := normalizeDashboardJSON(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldDashboard, newDashboard)
+}
+
+// normalizeDashboardJSON parses a v3 dashboard, either as exported from the UI or wrapped in a "dashboard" key,
+// and removes everything that is managed by Sysdig
+func normalizeDashboardJSON(value string) (map[string]interface{}, error) {
+	var dashboard map[string]interface{}
+	err := json.Unmarshal([]byte(value), &dashboard)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dashboard JSON: %w", err)
+	}
+	if dashboard == nil {
+		return nil, errors.New("invalid dashboard JSON: expected an object")
+	}
+
+	if wrapped, ok := dashboard["dashboard"].(map[string]interface{}); ok && len(dashboard) == 1 {
+		dashboard = wrapped
+	}
+
+	if _, ok := dashboard["name"].(string); !ok {
+		return nil, errors.New("invalid dashboard JSON: the dashboard must have a name")
+	}
+
+	normalizeDashboardJSONObject(dashboard)
+	return dashboard, nil
+}
+
+// normalizeDashboardJSONObject removes the server managed fields and renumbers the panels in order,
+// so that the layout does not depend on the IDs assigned by Sysdig
+func normalizeDashboardJSONObject(dashboard map[string]interface{}) {
+	for _, field := range dashboardJSONServerManagedFields {
+		delete(dashboard, field)
+	}
+
+	panelIDs := map[float64]int{}
+	panels, _ := dashboard["panels"].([]interface{})
+	for i, rawPanel := range panels {
+		panel, ok := rawPanel.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := panel["id"].(float64); ok {
+			panelIDs[id] = i + 1
+		}
+		panel["id"] = float64(i + 1)
+	}
+
+	layout, _ := dashboard["layout"].([]interface{})
+	for _, rawPosition := range layout {
+		position, ok := rawPosition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := position["panelId"].(float64); ok {
+			if newID, ok := panelIDs[id]; ok {
+				position["panelId"] = float64(newID)
+			}
+		}
+	}
+}
+
+// dashboardJSONProject keeps the values of actual which are set in shape, so that the fields Sysdig adds with their
+// default value are dropped while the configured ones changed or removed outside Terraform still show as a diff.
+// The items of actual beyond the ones of shape, e.g. panels added in the UI, are kept as they are.
+func dashboardJSONProject(actual, shape interface{}) interface{} {
+	switch shapeValue := shape.(type) {
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		result := map[string]interface{}{}
+		for k, v := range shapeValue {
+			if value, ok := actualValue[k]; ok {
+				result[k] = dashboardJSONProject(value, v)
+			}
+		}
+		return result
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok {
+			return actual
+		}
+		result := make([]interface{}, len(actualValue))
+		for i := range actualValue {
+			if i < len(shapeValue) {
+				result[i] = dashboardJSONProject(actualValue[i], shapeValue[i])
+			} else {
+				result[i] = actualValue[i]
+			}
+		}
+		return result
+	default:
+		return actual
+	}
+}
@@ -0,0 +1,87 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccDashboardJSON(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardJSON(rText(), 1),
+			},
+			{
+				Config: dashboardJSON(rText(), 42),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard_json.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// panelID simulates a dashboard exported from the UI, whose panel IDs are not the ones Sysdig will assign
+func dashboardJSON(name string, panelID int) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard_json" "dashboard" {
+	dashboard_json = jsonencode({
+		dashboard = {
+			id = 1234
+			version = 3
+			username = "someone@example.com"
+			name = "TERRAFORM TEST - DASHBOARD JSON %s"
+			description = "TERRAFORM TEST - DASHBOARD JSON"
+			schema = 3
+			panels = [
+				{
+					id = %d
+					type = "advancedTimechart"
+					name = "CPU"
+					description = ""
+					advancedQueries = [
+						{
+							enabled = true
+							query = "avg(sysdig_container_cpu_used_percent)"
+							id = 1
+							displayInfo = {
+								displayName = ""
+								timeSeriesDisplayNameTemplate = ""
+								type = "lines"
+							}
+						}
+					]
+				}
+			]
+			layout = [
+				{
+					panelId = %d
+					x = 0
+					y = 0
+					w = 12
+					h = 6
+				}
+			]
+		}
+	})
+}
+`, name, panelID, panelID)
+}
@@ -4,6 +4,7 @@ package sysdig_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
@@ -57,6 +58,87 @@ func TestAccDashboard(t *testing.T) {
 			{
 				Config: multiplePanelsDashboardWithDisplayInfo(rText()),
 			},
+			{
+				Config: teamSharedDashboard(rText(), `type = "SELECTED"
+		teams = [sysdig_monitor_team.a_team.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "team_sharing.0.type", "SELECTED"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "team_sharing.0.role", "ROLE_RESOURCE_READ"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "team_sharing.0.teams.#", "1"),
+				),
+			},
+			{
+				Config: teamSharedDashboard(rText(), `type = "ALL"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "team_sharing.0.type", "ALL"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "team_sharing.0.teams.#", "0"),
+				),
+			},
+			{
+				Config:      teamSharedDashboard(rText(), `type = "SELECTED"`),
+				ExpectError: regexp.MustCompile(`team_sharing of type SELECTED requires at least one team`),
+			},
+			{
+				Config: additionalPanelTypesDashboard(rText()),
+			},
+			{
+				Config: dashboardWithVariables(rText(), "$cluster"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "variable.#", "2"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "variable.0.default_values.0", "prod"),
+				),
+			},
+			{
+				Config:      dashboardWithVariables(rText(), "$undeclared"),
+				ExpectError: regexp.MustCompile(`references the undeclared variable \$undeclared`),
+			},
+			{
+				Config: autoLayoutDashboard(rText(), "row_template = [2, 3]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.1.pos_x", "12"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.1.width", "12"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.2.pos_y", "6"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.2.width", "8"),
+				),
+			},
+			{
+				Config: autoLayoutDashboard(rText(), ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.1.pos_x", "12"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.2.pos_x", "18"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.2.width", "6"),
+				),
+			},
+			{
+				Config: repeatedPanelsDashboard(rText(), `["kube-system", "default"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.#", "1"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel_repeat.0.values.#", "2"),
+				),
+			},
+			{
+				Config: repeatedPanelsDashboard(rText(), `["kube-system", "default", "monitoring"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.#", "1"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel.0.name", "all namespaces"),
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "panel_repeat.0.values.#", "3"),
+				),
+			},
+			{
+				Config:      overlappingPanelsDashboard(rText()),
+				ExpectError: regexp.MustCompile(`panels "first panel" and "second panel" overlap`),
+			},
+			{
+				Config: dashboardWithThresholdsAxesAndEventOverlay(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_dashboard.dashboard", "event_overlay.0.filter", "source = \"kubernetes\""),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: timeChartDashboardWithLegend(
 					rText(),
@@ -448,7 +530,7 @@ resource "sysdig_monitor_dashboard" "dashboard" {
             }
 		}
 		query {
-			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent{ns_name=$k8s_ns}[$__interval]))"
+			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent{ns_name=$k8_ns}[$__interval]))"
 			unit = "number"
 
             format {
@@ -689,7 +771,7 @@ resource "sysdig_monitor_dashboard" "dashboard" {
             }
 		}
 		query {
-			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent{ns_name=$k8s_ns}[$__interval]))"
+			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent{ns_name=$k8_ns}[$__interval]))"
 			unit = "number"
 			display_info {
 				time_series_display_name_template = "{{host_hostname}}"
@@ -853,3 +935,342 @@ resource "sysdig_monitor_dashboard" "dashboard" {
 		*format.MinInterval,
 	)
 }
+
+func additionalPanelTypesDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "table"
+		name = "table panel"
+
+		query {
+			promql = "sum by (kube_namespace_name) (sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+
+		table {
+			column {
+				key = "kube_namespace_name"
+				display_name = "Namespace"
+			}
+		}
+	}
+
+	panel {
+		pos_x = 12
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "toplist"
+		name = "toplist panel"
+
+		query {
+			promql = "topk(5, sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+
+		toplist {
+			sort_direction = "asc"
+			limit = 5
+		}
+	}
+
+	panel {
+		pos_x = 0
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "histogram"
+		name = "histogram panel"
+
+		query {
+			promql = "sysdig_container_memory_used_bytes"
+			unit = "data"
+		}
+
+		histogram {
+			buckets = 20
+		}
+	}
+
+	panel {
+		pos_x = 8
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "bar_chart"
+		name = "bar chart panel"
+
+		query {
+			promql = "sum by (kube_cluster_name) (sysdig_container_net_in_bytes)"
+			unit = "data rate"
+		}
+
+		legend {
+			show_current = true
+			position = "bottom"
+			layout = "inline"
+		}
+
+		left_axis {
+			display_name = "Bytes"
+		}
+	}
+
+	panel {
+		pos_x = 16
+		pos_y = 6
+		width = 8
+		height = 6
+		type = "event_stream"
+		name = "event stream panel"
+
+		event_stream {
+			filter = "kube_namespace_name = \"default\""
+			severities = ["high"]
+		}
+	}
+}
+`, name, name)
+}
+
+func dashboardWithThresholdsAxesAndEventOverlay(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	event_overlay {
+		filter = "source = \"kubernetes\""
+		severities = ["high", "medium"]
+	}
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "timechart"
+		name = "timechart panel"
+
+		query {
+			promql = "avg(sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+
+		left_axis {
+			display_name = "CPU"
+			max_value = "100"
+		}
+
+		right_axis {
+			enabled = false
+		}
+	}
+
+	panel {
+		pos_x = 12
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "number"
+		name = "number panel"
+
+		query {
+			promql = "avg(sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+
+		thresholds {
+			value = 80
+			severity = "medium"
+			display_text = "busy"
+		}
+
+		thresholds {
+			value = 95
+			severity = "high"
+		}
+	}
+}
+`, name, name)
+}
+
+func dashboardWithVariables(name, clusterVariable string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	variable {
+		name = "cluster"
+		label = "kube_cluster_name"
+		display_name = "Cluster"
+		allowed_values = ["prod", "staging"]
+		default_values = ["prod"]
+		multi_select = false
+	}
+
+	variable {
+		name = "namespace"
+		label = "kube_namespace_name"
+	}
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "timechart"
+		name = "timechart panel"
+
+		query {
+			promql = "avg(sysdig_container_cpu_used_percent{kube_cluster_name=%s, kube_namespace_name=$namespace}[$__interval])"
+			unit = "percent"
+		}
+	}
+}
+`, name, name, clusterVariable)
+}
+
+func autoLayoutDashboard(name, autoLayout string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+	description = "TERRAFORM TEST - METRIC %s"
+
+	auto_layout {
+		%s
+	}
+
+	panel {
+		type = "timechart"
+		name = "cpu"
+
+		query {
+			promql = "avg(avg_over_time(sysdig_host_cpu_used_percent[$__interval]))"
+			unit = "percent"
+		}
+	}
+
+	panel {
+		type = "number"
+		name = "memory"
+
+		query {
+			promql = "avg(avg_over_time(sysdig_host_memory_used_percent[$__interval]))"
+			unit = "percent"
+		}
+	}
+
+	panel {
+		type = "text"
+		name = "notes"
+		content = "Placed automatically"
+	}
+}
+`, name, name, autoLayout)
+}
+
+func overlappingPanelsDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "text"
+		name = "first panel"
+		content = "first"
+	}
+
+	panel {
+		pos_x = 6
+		pos_y = 4
+		width = 12
+		height = 6
+		type = "text"
+		name = "second panel"
+		content = "second"
+	}
+}
+`, name)
+}
+
+func teamSharedDashboard(name, teamSharing string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_team" "a_team" {
+  name      = "sample-%s"
+
+  entrypoint {
+	type = "Explore"
+  }
+}
+
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 12
+		height = 6
+		type = "text"
+		name = "example panel"
+		content = "shared with the teams"
+	}
+
+	team_sharing {
+		%s
+	}
+}
+`, name, name, teamSharing)
+}
+
+func repeatedPanelsDashboard(name, namespaces string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_dashboard" "dashboard" {
+	name = "TERRAFORM TEST - METRIC %s"
+
+	panel {
+		pos_x = 0
+		pos_y = 0
+		width = 24
+		height = 6
+		type = "timechart"
+		name = "all namespaces"
+
+		query {
+			promql = "sum(sysdig_container_cpu_used_percent)"
+			unit = "percent"
+		}
+	}
+
+	panel_repeat {
+		values = %s
+		columns = 3
+
+		panel {
+			type = "timechart"
+			name = "{{value}} CPU"
+			description = "CPU used in the {{value}} namespace"
+
+			query {
+				promql = "sum(sysdig_container_cpu_used_percent{kube_namespace_name=\"{{value}}\"})"
+				unit = "percent"
+			}
+		}
+	}
+}
+`, name, namespaces)
+}
@@ -0,0 +1,96 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestOrderStatePanels(t *testing.T) {
+	dashboard := &v2.Dashboard{
+		Panels: []*v2.Panels{
+			{ID: 1, Name: "cpu"},
+			{ID: 2, Name: "memory"},
+			{ID: 3, Name: "cpu"},
+		},
+		Layout: []*v2.Layout{
+			{PanelID: 1, X: 0, Y: 0},
+			{PanelID: 2, X: 12, Y: 0},
+			{PanelID: 3, X: 0, Y: 6},
+		},
+	}
+	panels := []interface{}{
+		map[string]interface{}{"name": "cpu", "pos_x": float64(0), "pos_y": float64(6)},
+		map[string]interface{}{"name": "removed", "pos_x": float64(12), "pos_y": float64(6)},
+		map[string]interface{}{"name": "memory", "pos_x": float64(12), "pos_y": float64(0)},
+		map[string]interface{}{"name": "cpu", "pos_x": float64(0), "pos_y": float64(0)},
+	}
+
+	ordered := orderStatePanels(panels, dashboard)
+
+	assert.Equal(t, []interface{}{panels[3], panels[2], panels[0], panels[1]}, ordered)
+}
+
+func TestBarChartAndEventStreamPanelFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSysdigMonitorDashboard().Schema, map[string]interface{}{
+		"panel": []interface{}{
+			map[string]interface{}{
+				"type":   "bar_chart",
+				"name":   "bar chart",
+				"pos_x":  0,
+				"pos_y":  0,
+				"width":  12,
+				"height": 6,
+				"query":  []interface{}{map[string]interface{}{"promql": "sum(up)", "unit": "number"}},
+				"left_axis": []interface{}{
+					map[string]interface{}{"display_name": "Targets"},
+				},
+			},
+			map[string]interface{}{
+				"type":   "event_stream",
+				"name":   "events",
+				"pos_x":  12,
+				"pos_y":  0,
+				"width":  12,
+				"height": 8,
+				"event_stream": []interface{}{
+					map[string]interface{}{"filter": `kube_namespace_name = "default"`, "severities": []interface{}{"high"}},
+				},
+			},
+			map[string]interface{}{
+				"type":   "table",
+				"name":   "table",
+				"pos_x":  0,
+				"pos_y":  8,
+				"width":  24,
+				"height": 8,
+				"query":  []interface{}{map[string]interface{}{"promql": "sum(up)", "unit": "number"}},
+				"left_axis": []interface{}{
+					map[string]interface{}{"display_name": "Targets"},
+				},
+			},
+		},
+	})
+	panels := d.Get("panel").([]interface{})
+
+	barChart, err := panelFromResourceData(panels[0].(map[string]interface{}))
+	assert.NoError(t, err)
+	assert.Equal(t, v2.PanelTypeBarChart, barChart.Type)
+	assert.Equal(t, "Targets", barChart.AxesConfiguration.Left.DisplayName)
+	assert.NotNil(t, barChart.LegendConfiguration)
+
+	eventStream, err := panelFromResourceData(panels[1].(map[string]interface{}))
+	assert.NoError(t, err)
+	assert.Equal(t, v2.PanelTypeEventStream, eventStream.Type)
+	assert.Equal(t, `kube_namespace_name = "default"`, eventStream.EventsQueryParams.Filter)
+	assert.Equal(t, []interface{}{"high"}, eventStream.EventsQueryParams.Severities)
+	assert.Empty(t, eventStream.AdvancedQueries)
+
+	_, err = panelFromResourceData(panels[2].(map[string]interface{}))
+	assert.ErrorContains(t, err, "the left_axis block can only be set on panels of type timechart or bar_chart")
+}
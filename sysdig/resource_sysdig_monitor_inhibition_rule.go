@@ -0,0 +1,252 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSysdigMonitorInhibitionRule() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorInhibitionRuleCreate,
+		UpdateContext: resourceSysdigMonitorInhibitionRuleUpdate,
+		ReadContext:   resourceSysdigMonitorInhibitionRuleRead,
+		DeleteContext: resourceSysdigMonitorInhibitionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"source_matchers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     inhibitionRuleMatcherSchema(),
+			},
+			"target_matchers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     inhibitionRuleMatcherSchema(),
+			},
+			"equal": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func inhibitionRuleMatcherSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EQUALS", "NOT_EQUALS", "REGEXP_MATCHES", "NOT_REGEXP_MATCHES"}, false),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func getMonitorInhibitionRuleClient(c SysdigClients) (v2.InhibitionRuleInterface, error) {
+	var client v2.InhibitionRuleInterface
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+func resourceSysdigMonitorInhibitionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorInhibitionRuleClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inhibitionRule := monitorInhibitionRuleFromResourceData(d)
+
+	inhibitionRule, err = client.CreateInhibitionRule(ctx, inhibitionRule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(inhibitionRule.ID))
+
+	return resourceSysdigMonitorInhibitionRuleRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorInhibitionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorInhibitionRuleClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inhibitionRule, err := client.GetInhibitionRule(ctx, id)
+	if err != nil {
+		if err == v2.InhibitionRuleNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = monitorInhibitionRuleToResourceData(inhibitionRule, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorInhibitionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorInhibitionRuleClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inhibitionRule := monitorInhibitionRuleFromResourceData(d)
+
+	inhibitionRule.Version = d.Get("version").(int)
+	inhibitionRule.ID, err = strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateInhibitionRule(ctx, inhibitionRule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorInhibitionRuleRead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorInhibitionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorInhibitionRuleClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteInhibitionRule(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func monitorInhibitionRuleFromResourceData(d *schema.ResourceData) v2.InhibitionRule {
+	inhibitionRule := v2.InhibitionRule{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Enabled:        d.Get("enabled").(bool),
+		SourceMatchers: inhibitionRuleMatchersFromList(d.Get("source_matchers").([]interface{})),
+		TargetMatchers: inhibitionRuleMatchersFromList(d.Get("target_matchers").([]interface{})),
+	}
+
+	for _, rawLabel := range d.Get("equal").([]interface{}) {
+		if label, ok := rawLabel.(string); ok {
+			inhibitionRule.Equal = append(inhibitionRule.Equal, label)
+		}
+	}
+
+	return inhibitionRule
+}
+
+func inhibitionRuleMatchersFromList(list []interface{}) []v2.InhibitionRuleMatcher {
+	matchers := make([]v2.InhibitionRuleMatcher, 0, len(list))
+	for _, rawMatcher := range list {
+		matcherMap := rawMatcher.(map[string]interface{})
+		matchers = append(matchers, v2.InhibitionRuleMatcher{
+			LabelName: matcherMap["label_name"].(string),
+			Operator:  matcherMap["operator"].(string),
+			Value:     matcherMap["value"].(string),
+		})
+	}
+	return matchers
+}
+
+func inhibitionRuleMatchersToList(matchers []v2.InhibitionRuleMatcher) []interface{} {
+	list := make([]interface{}, 0, len(matchers))
+	for _, matcher := range matchers {
+		list = append(list, map[string]interface{}{
+			"label_name": matcher.LabelName,
+			"operator":   matcher.Operator,
+			"value":      matcher.Value,
+		})
+	}
+	return list
+}
+
+func monitorInhibitionRuleToResourceData(inhibitionRule v2.InhibitionRule, d *schema.ResourceData) (err error) {
+	_ = d.Set("name", inhibitionRule.Name)
+	_ = d.Set("description", inhibitionRule.Description)
+	_ = d.Set("enabled", inhibitionRule.Enabled)
+	_ = d.Set("source_matchers", inhibitionRuleMatchersToList(inhibitionRule.SourceMatchers))
+	_ = d.Set("target_matchers", inhibitionRuleMatchersToList(inhibitionRule.TargetMatchers))
+	_ = d.Set("equal", inhibitionRule.Equal)
+	_ = d.Set("version", inhibitionRule.Version)
+	return nil
+}
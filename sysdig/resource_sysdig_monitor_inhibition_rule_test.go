@@ -0,0 +1,120 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorInhibitionRule(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorInhibitionRuleWithName(rText()),
+			},
+			{
+				ResourceName:      "sysdig_monitor_inhibition_rule.sample1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: monitorInhibitionRuleWithEqual(rText()),
+			},
+			{
+				ResourceName:      "sysdig_monitor_inhibition_rule.sample2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: monitorInhibitionRuleWithMultipleMatchers(rText()),
+			},
+			{
+				ResourceName:      "sysdig_monitor_inhibition_rule.sample3",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func monitorInhibitionRuleWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_inhibition_rule" "sample1" {
+	name = "Example Inhibition Rule %s"
+	enabled = false
+	source_matchers {
+		label_name = "alertname"
+		operator = "EQUALS"
+		value = "KubernetesClusterDown"
+	}
+	target_matchers {
+		label_name = "alertname"
+		operator = "NOT_EQUALS"
+		value = "KubernetesClusterDown"
+	}
+}`, name)
+}
+
+func monitorInhibitionRuleWithEqual(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_inhibition_rule" "sample2" {
+	name = "Example Inhibition Rule %s"
+	description = "Example description"
+	enabled = false
+	source_matchers {
+		label_name = "severity"
+		operator = "EQUALS"
+		value = "high"
+	}
+	target_matchers {
+		label_name = "severity"
+		operator = "REGEXP_MATCHES"
+		value = "low|medium"
+	}
+	equal = ["kube_cluster_name", "kube_namespace_name"]
+}`, name)
+}
+
+func monitorInhibitionRuleWithMultipleMatchers(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_inhibition_rule" "sample3" {
+	name = "Example Inhibition Rule %s"
+	enabled = false
+	source_matchers {
+		label_name = "alertname"
+		operator = "EQUALS"
+		value = "KubernetesClusterDown"
+	}
+	source_matchers {
+		label_name = "severity"
+		operator = "EQUALS"
+		value = "high"
+	}
+	target_matchers {
+		label_name = "alertname"
+		operator = "NOT_REGEXP_MATCHES"
+		value = "KubernetesCluster.*"
+	}
+	target_matchers {
+		label_name = "kube_namespace_name"
+		operator = "NOT_EQUALS"
+		value = "kube-system"
+	}
+	equal = ["kube_cluster_name"]
+}`, name)
+}
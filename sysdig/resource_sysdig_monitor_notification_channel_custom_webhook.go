@@ -32,7 +32,7 @@ func resourceSysdigMonitorNotificationChannelCustomWebhook() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK, map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -71,7 +71,7 @@ func resourceSysdigMonitorNotificationChannelCustomWebhookCreate(ctx context.Con
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelCustomWebhookFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelCustomWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -102,7 +102,7 @@ func resourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx context.Conte
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelCustomWebhookToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelCustomWebhookToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,7 +121,7 @@ func resourceSysdigMonitorNotificationChannelCustomWebhookUpdate(ctx context.Con
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelCustomWebhookFromResourceData(d, teamID)
+	nc, err := notificationChannelCustomWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -134,7 +134,7 @@ func resourceSysdigMonitorNotificationChannelCustomWebhookUpdate(ctx context.Con
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelCustomWebhookRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelCustomWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,36 +152,3 @@ func resourceSysdigMonitorNotificationChannelCustomWebhookDelete(ctx context.Con
 
 	return nil
 }
-
-func monitorNotificationChannelCustomWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.HttpMethod = d.Get("http_method").(string)
-	nc.Options.MonitorTemplate = d.Get("template").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]interface{})
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
-	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return
-}
-
-func monitorNotificationChannelCustomWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-	_ = d.Set("http_method", nc.Options.HttpMethod)
-	_ = d.Set("template", nc.Options.MonitorTemplate)
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return
-}
@@ -28,41 +28,46 @@ func TestAccMonitorNotificationChannelCustomWebhook(t *testing.T) {
 				Config: monitorNotificationChannelCustomWebhookWithName(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook1",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelCustomWebhookWithNameWithAdditionalheaders(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelCustomWebhookSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelCustomWebhookSharedWithAllowInsecureConnections(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelCustomWebhookSharedWithAdditionalHeaders(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook5",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_custom_webhook.sample-custom-webhook5",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 		},
 	})
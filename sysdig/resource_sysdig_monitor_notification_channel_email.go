@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/spf13/cast"
 )
 
 func resourceSysdigMonitorNotificationChannelEmail() *schema.Resource {
@@ -32,7 +31,7 @@ func resourceSysdigMonitorNotificationChannelEmail() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"recipients": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -53,7 +52,7 @@ func resourceSysdigMonitorNotificationChannelEmailCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelEmailFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -84,7 +83,7 @@ func resourceSysdigMonitorNotificationChannelEmailRead(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelEmailToResourceData(&nc, d)
+	err = notificationChannelEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -103,7 +102,7 @@ func resourceSysdigMonitorNotificationChannelEmailUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelEmailFromResourceData(d, teamID)
+	nc, err := notificationChannelEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -134,25 +133,3 @@ func resourceSysdigMonitorNotificationChannelEmailDelete(ctx context.Context, d
 
 	return nil
 }
-
-func monitorNotificationChannelEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_EMAIL
-	nc.Options.EmailRecipients = cast.ToStringSlice(d.Get("recipients").(*schema.Set).List())
-	return
-}
-
-func monitorNotificationChannelEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("recipients", nc.Options.EmailRecipients)
-
-	return
-}
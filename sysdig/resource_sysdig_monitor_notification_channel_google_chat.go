@@ -30,7 +30,7 @@ func resourceSysdigMonitorNotificationChannelGoogleChat() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,7 +50,7 @@ func resourceSysdigMonitorNotificationChannelGoogleChatCreate(ctx context.Contex
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelGoogleChatFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelGoogleChatFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +85,7 @@ func resourceSysdigMonitorNotificationChannelGoogleChatRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelGoogleChatToResourceData(&nc, d)
+	err = notificationChannelGoogleChatToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,7 +104,7 @@ func resourceSysdigMonitorNotificationChannelGoogleChatUpdate(ctx context.Contex
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelGoogleChatFromResourceData(d, teamID)
+	nc, err := notificationChannelGoogleChatFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -141,25 +141,3 @@ func resourceSysdigMonitorNotificationChannelGoogleChatDelete(ctx context.Contex
 
 	return nil
 }
-
-func monitorNotificationChannelGoogleChatFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_GCHAT
-	nc.Options.Url = d.Get("url").(string)
-	return
-}
-
-func monitorNotificationChannelGoogleChatToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-
-	return
-}
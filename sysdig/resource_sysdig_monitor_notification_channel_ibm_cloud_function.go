@@ -32,7 +32,7 @@ func resourceSysdigMonitorNotificationChannelIBMFunction() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION, map[string]*schema.Schema{
 			"ibm_function_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -69,7 +69,7 @@ func resourceSysdigMonitorNotificationChannelIBMFunctionCreate(ctx context.Conte
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelIBMFunctionFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelIBMFunctionFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -100,7 +100,7 @@ func resourceSysdigMonitorNotificationChannelIBMFunctionRead(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelIBMFunctionToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelIBMFunctionToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -119,7 +119,7 @@ func resourceSysdigMonitorNotificationChannelIBMFunctionUpdate(ctx context.Conte
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelIBMFunctionFromResourceData(d, teamID)
+	nc, err := notificationChannelIBMFunctionFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,7 +132,7 @@ func resourceSysdigMonitorNotificationChannelIBMFunctionUpdate(ctx context.Conte
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelIBMFunctionRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelIBMFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -150,49 +150,3 @@ func resourceSysdigMonitorNotificationChannelIBMFunctionDelete(ctx context.Conte
 
 	return nil
 }
-
-func monitorNotificationChannelIBMFunctionFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION
-	nc.Options.IbmFunctionType = d.Get("ibm_function_type").(string)
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.CustomData = d.Get("custom_data").(map[string]interface{})
-	if nc.Options.IbmFunctionType == "CLOUD_FUNCTION" {
-		nc.Options.APIKey = d.Get("iam_api_key").(string)
-	} else {
-		nc.Options.APIKey = ""
-	}
-	if nc.Options.IbmFunctionType == "WEB_ACTION" {
-		nc.Options.AdditionalHeaders = map[string]interface{}{
-			"X-Require-Whisk-Auth": d.Get("whisk_auth_token").(string),
-		}
-	} else {
-		nc.Options.AdditionalHeaders = map[string]interface{}{}
-	}
-
-	return
-}
-
-func monitorNotificationChannelIBMFunctionToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("ibm_function_type", nc.Options.IbmFunctionType)
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("custom_data", nc.Options.CustomData)
-	_ = d.Set("iam_api_key", nc.Options.APIKey)
-	if nc.Options.AdditionalHeaders != nil {
-		whishAuthToken, ok := nc.Options.AdditionalHeaders["X-Require-Whisk-Auth"]
-		if ok {
-			_ = d.Set("whisk_auth_token", whishAuthToken)
-		}
-	}
-
-	return
-}
@@ -28,41 +28,46 @@ func TestAccMonitorNotificationChannelIBMCloudFunction(t *testing.T) {
 				Config: monitorNotificationChannelIBMCloudFunctionWebAction(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_ibm_function.sample1",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_ibm_function.sample1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_api_key", "whisk_auth_token"},
 			},
 			{
 				Config: monitorNotificationChannelIBMCloudFunctionWebActionWithWishAuthToken(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_ibm_function.sample2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_ibm_function.sample2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_api_key", "whisk_auth_token"},
 			},
 			{
 				Config: monitorNotificationChannelIBMCloudFunctionWebActionWithWishAuthTokenWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_ibm_function.sample3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_ibm_function.sample3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_api_key", "whisk_auth_token"},
 			},
 			{
 				Config: monitorNotificationChannelIBMCloudFunctionWebActionWithCustomData(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_ibm_function.sample4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_ibm_function.sample4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_api_key", "whisk_auth_token"},
 			},
 			{
 				Config: monitorNotificationChannelIBMCloudFunctionCloudFunction(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_ibm_function.sample5",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_ibm_function.sample5",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"iam_api_key", "whisk_auth_token"},
 			},
 		},
 	})
@@ -30,7 +30,7 @@ func resourceSysdigMonitorNotificationChannelIBMEventNotification() *schema.Reso
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,7 +50,7 @@ func resourceSysdigMonitorNotificationChannelIBMEventNotificationCreate(ctx cont
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelIBMEventNotificationFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelIBMEventNotificationFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +85,7 @@ func resourceSysdigMonitorNotificationChannelIBMEventNotificationRead(ctx contex
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelIBMEventNotificationToResourceData(&nc, d)
+	err = notificationChannelIBMEventNotificationToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,7 +104,7 @@ func resourceSysdigMonitorNotificationChannelIBMEventNotificationUpdate(ctx cont
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelIBMEventNotificationFromResourceData(d, teamID)
+	nc, err := notificationChannelIBMEventNotificationFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -141,25 +141,3 @@ func resourceSysdigMonitorNotificationChannelIBMEventNotificationDelete(ctx cont
 
 	return nil
 }
-
-func monitorNotificationChannelIBMEventNotificationFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_IBM_EVENT_NOTIFICATION
-	nc.Options.InstanceId = d.Get("instance_id").(string)
-	return
-}
-
-func monitorNotificationChannelIBMEventNotificationToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("instance_id", nc.Options.InstanceId)
-
-	return
-}
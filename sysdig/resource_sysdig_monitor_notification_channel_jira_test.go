@@ -0,0 +1,82 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelJira(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelJiraWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_jira.sample_jira1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+			{
+				Config: monitorNotificationChannelJiraSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_jira.sample_jira2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelJiraWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_jira" "sample_jira1" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	username = "sysdig@example.com"
+	api_token = "XXXXXXXXXX"
+	issue_mapping {
+		project = "OPS"
+		issue_type = "Task"
+	}
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func monitorNotificationChannelJiraSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_jira" "sample_jira2" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	username = "sysdig@example.com"
+	api_token = "XXXXXXXXXX"
+	issue_mapping {
+		project = "OPS"
+		issue_type = "Task"
+	}
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
@@ -30,11 +30,12 @@ func resourceSysdigMonitorNotificationChannelMSTeams() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationSchema(),
 		}),
 	}
 }
@@ -50,7 +51,7 @@ func resourceSysdigMonitorNotificationChannelMSTeamsCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelMSTeamsFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelMSTeamsFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +86,7 @@ func resourceSysdigMonitorNotificationChannelMSTeamsRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelMSTeamsToResourceData(&nc, d)
+	err = notificationChannelMSTeamsToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,7 +105,7 @@ func resourceSysdigMonitorNotificationChannelMSTeamsUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelMSTeamsFromResourceData(d, teamID)
+	nc, err := notificationChannelMSTeamsFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -141,25 +142,3 @@ func resourceSysdigMonitorNotificationChannelMSTeamsDelete(ctx context.Context,
 
 	return nil
 }
-
-func monitorNotificationChannelMSTeamsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_MS_TEAMS
-	nc.Options.Url = d.Get("url").(string)
-	return
-}
-
-func monitorNotificationChannelMSTeamsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-
-	return
-}
@@ -40,6 +40,18 @@ func TestAccMonitorNotificationChannelMSTeams(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: monitorNotificationChannelMSTeamsWithTemplateConfiguration(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_msteams.sample-msteams1", "template_configuration.0.template_key", "MS_TEAMS_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_msteams.sample-msteams1", "template_configuration.0.section.0.show", "false"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_notification_channel_msteams.sample-msteams1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -66,3 +78,21 @@ resource "sysdig_monitor_notification_channel_msteams" "sample-msteams2" {
 	notify_when_resolved = true
 }`, name)
 }
+
+func monitorNotificationChannelMSTeamsWithTemplateConfiguration(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_msteams" "sample-msteams1" {
+	name = "Example Channel %s - MS Teams"
+	enabled = true
+	url = "https://hooks.msteams.cwom/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	template_configuration {
+		template_key = "MS_TEAMS_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"
+		section {
+			name = "MONITOR_ALERT_NOTIFICATION_CHART"
+			show = false
+		}
+	}
+}`, name)
+}
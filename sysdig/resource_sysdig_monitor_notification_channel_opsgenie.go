@@ -32,7 +32,7 @@ func resourceSysdigMonitorNotificationChannelOpsGenie() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_OPSGENIE, map[string]*schema.Schema{
 			"api_key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -58,7 +58,7 @@ func resourceSysdigMonitorNotificationChannelOpsGenieCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelOpsGenieFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelOpsGenieFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,7 +89,7 @@ func resourceSysdigMonitorNotificationChannelOpsGenieRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelOpsGenieToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelOpsGenieToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,7 +108,7 @@ func resourceSysdigMonitorNotificationChannelOpsGenieUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelOpsGenieFromResourceData(d, teamID)
+	nc, err := notificationChannelOpsGenieFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -121,7 +121,7 @@ func resourceSysdigMonitorNotificationChannelOpsGenieUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelOpsGenieRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelOpsGenieDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -139,28 +139,3 @@ func resourceSysdigMonitorNotificationChannelOpsGenieDelete(ctx context.Context,
 
 	return nil
 }
-
-func monitorNotificationChannelOpsGenieFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_OPSGENIE
-	apiKey := d.Get("api_key").(string)
-	nc.Options.APIKey = apiKey
-	nc.Options.Region = d.Get("region").(string)
-	return
-}
-
-func monitorNotificationChannelOpsGenieToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("api_key", nc.Options.APIKey)
-	_ = d.Set("region", nc.Options.Region)
-
-	return
-}
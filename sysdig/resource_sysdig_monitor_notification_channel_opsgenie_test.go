@@ -4,6 +4,7 @@ package sysdig_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,7 @@ import (
 
 func TestAccMonitorNotificationChannelOpsGenie(t *testing.T) {
 	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+	name := rText()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: sysdigOrIBMMonitorPreCheck(t),
@@ -26,28 +28,41 @@ func TestAccMonitorNotificationChannelOpsGenie(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: monitorNotificationChannelOpsGenieWithName(rText()),
+				Config: monitorNotificationChannelOpsGenieWithName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("sysdig_monitor_notification_channel_opsgenie.sample-opsgenie", "api_key", regexp.MustCompile("^sha256:")),
+				),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: monitorNotificationChannelOpsGenieWithRotatedAPIKey(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("sysdig_monitor_notification_channel_opsgenie.sample-opsgenie", "api_key", regexp.MustCompile("^sha256:")),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_opsgenie.sample-opsgenie", "secret_version", "2"),
+				),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key", "secret_version"},
 			},
 			{
 				Config: monitorNotificationChannelOpsGenieWithNameAndRegion(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie-2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie-2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
 			},
 			{
 				Config: monitorNotificationChannelOpsGenieSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie-3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_opsgenie.sample-opsgenie-3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
 			},
 		},
 	})
@@ -64,6 +79,18 @@ resource "sysdig_monitor_notification_channel_opsgenie" "sample-opsgenie" {
 }`, name)
 }
 
+func monitorNotificationChannelOpsGenieWithRotatedAPIKey(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_opsgenie" "sample-opsgenie" {
+	name = "Example Channel %s - OpsGenie"
+	enabled = true
+	api_key = "5823945-234234234-2342-95"
+	secret_version = "2"
+	notify_when_ok = false
+	notify_when_resolved = false
+}`, name)
+}
+
 func monitorNotificationChannelOpsGenieWithNameAndRegion(name string) string {
 	return fmt.Sprintf(`
 resource "sysdig_monitor_notification_channel_opsgenie" "sample-opsgenie-2" {
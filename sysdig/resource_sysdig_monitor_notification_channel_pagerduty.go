@@ -31,7 +31,7 @@ func resourceSysdigMonitorNotificationChannelPagerduty() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_PAGERDUTY, map[string]*schema.Schema{
 			"account": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -59,7 +59,7 @@ func resourceSysdigMonitorNotificationChannelPagerdutyCreate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelPagerdutyFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelPagerdutyFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,7 +90,7 @@ func resourceSysdigMonitorNotificationChannelPagerdutyRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelPagerdutyToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelPagerdutyToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,7 +109,7 @@ func resourceSysdigMonitorNotificationChannelPagerdutyUpdate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelPagerdutyFromResourceData(d, teamID)
+	nc, err := notificationChannelPagerdutyFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,7 +122,7 @@ func resourceSysdigMonitorNotificationChannelPagerdutyUpdate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelPagerdutyRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelPagerdutyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -140,30 +140,3 @@ func resourceSysdigMonitorNotificationChannelPagerdutyDelete(ctx context.Context
 
 	return nil
 }
-
-func monitorNotificationChannelPagerdutyFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_PAGERDUTY
-	nc.Options.Account = d.Get("account").(string)
-	nc.Options.ServiceKey = d.Get("service_key").(string)
-	nc.Options.ServiceName = d.Get("service_name").(string)
-
-	return
-}
-
-func monitorNotificationChannelPagerdutyToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("account", nc.Options.Account)
-	_ = d.Set("service_key", nc.Options.ServiceKey)
-	_ = d.Set("service_name", nc.Options.ServiceName)
-
-	return
-}
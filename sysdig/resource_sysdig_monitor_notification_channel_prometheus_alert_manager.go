@@ -31,7 +31,7 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManager() *schema.Re
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER, map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,7 +60,7 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerCreate(ctx co
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -91,7 +91,7 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead(ctx cont
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelPrometheusAlertManagerToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelPrometheusAlertManagerToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -110,7 +110,7 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerUpdate(ctx co
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
+	nc, err := notificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -123,7 +123,7 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerUpdate(ctx co
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelPrometheusAlertManagerRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -141,33 +141,3 @@ func resourceSysdigMonitorNotificationChannelPrometheusAlertManagerDelete(ctx co
 
 	return nil
 }
-
-func monitorNotificationChannelPrometheusAlertManagerFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]interface{})
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
-	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return
-}
-
-func monitorNotificationChannelPrometheusAlertManagerToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return
-}
@@ -28,33 +28,37 @@ func TestAccMonitorNotificationChannelPrometheusAlertManager(t *testing.T) {
 				Config: monitorNotificationChannelPrometheusAlertManagerWithName(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel1",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelPrometheusAlertManagerWithNameWithAdditionalheaders(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelPrometheusAlertManagerWithNameWithAllowInsecureConnections(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelPrometheusAlertManagerSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_prometheus_alert_manager.sample-channel4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 		},
 	})
@@ -0,0 +1,74 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelServiceNow(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelServiceNowWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_servicenow.sample_servicenow1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: monitorNotificationChannelServiceNowSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_servicenow.sample_servicenow2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelServiceNowWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_servicenow" "sample_servicenow1" {
+	name = "Example Channel %s - ServiceNow"
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func monitorNotificationChannelServiceNowSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_servicenow" "sample_servicenow2" {
+	name = "Example Channel %s - ServiceNow"
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
@@ -30,7 +30,7 @@ func resourceSysdigMonitorNotificationChannelSlack() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -40,40 +40,55 @@ func resourceSysdigMonitorNotificationChannelSlack() *schema.Resource {
 				Required: true,
 			},
 			"show_section_runbook_links": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_event_details": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_user_defined_content": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_notification_chart": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_dashboard_links": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_alert_details": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
 			"show_section_capturing_information": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationSchema(monitorNotificationChannelSlackSections...),
 		}),
 	}
 }
@@ -184,51 +199,32 @@ func resourceSysdigMonitorNotificationChannelSlackDelete(ctx context.Context, d
 }
 
 func monitorNotificationChannelSlackFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
+	nc, err = notificationChannelSlackFromResourceData(d, teamID)
 	if err != nil {
 		return
 	}
 
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_SLACK
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.Channel = d.Get("channel").(string)
+	// the sections can also be configured with template_configuration, which is then sent instead
+	if notificationChannelTemplateConfigurationConfigured(d) {
+		return
+	}
+
+	sections := []v2.NotificationChannelTemplateConfigurationSection{
+		{
+			SectionName: "MONITOR_ALERT_NOTIFICATION_HEADER",
+			ShouldShow:  true,
+		},
+	}
+	for _, attribute := range monitorNotificationChannelSlackSections {
+		sections = append(sections, v2.NotificationChannelTemplateConfigurationSection{
+			SectionName: monitorNotificationChannelSlackSectionNames[attribute],
+			ShouldShow:  d.Get(attribute).(bool),
+		})
+	}
 	nc.Options.TemplateConfiguration = []v2.NotificationChannelTemplateConfiguration{
 		{
-			TemplateKey: "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1",
-			TemplateConfigurationSections: []v2.NotificationChannelTemplateConfigurationSection{
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_HEADER",
-					ShouldShow:  true,
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS",
-					ShouldShow:  d.Get("show_section_runbook_links").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS",
-					ShouldShow:  d.Get("show_section_event_details").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT",
-					ShouldShow:  d.Get("show_section_user_defined_content").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_CHART",
-					ShouldShow:  d.Get("show_section_notification_chart").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS",
-					ShouldShow:  d.Get("show_section_dashboard_links").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS",
-					ShouldShow:  d.Get("show_section_alert_details").(bool),
-				},
-				{
-					SectionName: "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION",
-					ShouldShow:  d.Get("show_section_capturing_information").(bool),
-				},
-			},
+			TemplateKey:                   NOTIFICATION_CHANNEL_TYPE_SLACK_MONITOR_TEMPLATE_KEY_V1,
+			TemplateConfigurationSections: sections,
 		},
 	}
 
@@ -236,50 +232,38 @@ func monitorNotificationChannelSlackFromResourceData(d *schema.ResourceData, tea
 }
 
 func monitorNotificationChannelSlackToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
+	err = notificationChannelSlackToResourceData(nc, d)
 	if err != nil {
 		return
 	}
 
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("channel", nc.Options.Channel)
-
-	runbookLinks := true
-	eventDetails := true
-	userDefinedContent := true
-	notificationChart := true
-	dashboardLinks := true
-	alertDetails := true
-	capturingInformation := true
-
-	if len(nc.Options.TemplateConfiguration) == 1 {
-		for _, c := range nc.Options.TemplateConfiguration[0].TemplateConfigurationSections {
-			switch c.SectionName {
-			case "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS":
-				runbookLinks = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS":
-				eventDetails = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT":
-				userDefinedContent = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_CHART":
-				notificationChart = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS":
-				dashboardLinks = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS":
-				alertDetails = c.ShouldShow
-			case "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION":
-				capturingInformation = c.ShouldShow
-			}
-		}
+	// the sections are the ones of the monitor alert template, the ones the API omits being shown
+	_, shown := notificationChannelTemplateSectionsOf(nc, NOTIFICATION_CHANNEL_TYPE_SLACK_MONITOR_TEMPLATE_KEY_V1)
+	for _, attribute := range monitorNotificationChannelSlackSections {
+		show, ok := shown[monitorNotificationChannelSlackSectionNames[attribute]]
+		_ = d.Set(attribute, !ok || show)
 	}
 
-	_ = d.Set("show_section_runbook_links", runbookLinks)
-	_ = d.Set("show_section_event_details", eventDetails)
-	_ = d.Set("show_section_user_defined_content", userDefinedContent)
-	_ = d.Set("show_section_notification_chart", notificationChart)
-	_ = d.Set("show_section_dashboard_links", dashboardLinks)
-	_ = d.Set("show_section_alert_details", alertDetails)
-	_ = d.Set("show_section_capturing_information", capturingInformation)
-
 	return
 }
+
+// monitorNotificationChannelSlackSections are the show_section_* attributes, in the order of the template sections
+var monitorNotificationChannelSlackSections = []string{
+	"show_section_runbook_links",
+	"show_section_event_details",
+	"show_section_user_defined_content",
+	"show_section_notification_chart",
+	"show_section_dashboard_links",
+	"show_section_alert_details",
+	"show_section_capturing_information",
+}
+
+var monitorNotificationChannelSlackSectionNames = map[string]string{
+	"show_section_runbook_links":         "MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS",
+	"show_section_event_details":         "MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS",
+	"show_section_user_defined_content":  "MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT",
+	"show_section_notification_chart":    "MONITOR_ALERT_NOTIFICATION_CHART",
+	"show_section_dashboard_links":       "MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS",
+	"show_section_alert_details":         "MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS",
+	"show_section_capturing_information": "MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION",
+}
@@ -43,6 +43,19 @@ func TestAccMonitorNotificationChannelSlack(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: monitorNotificationChannelSlackWithTemplateConfiguration(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_slack.sample-slack", "template_configuration.0.template_key", "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_slack.sample-slack", "template_configuration.0.section.0.show", "false"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_channel_slack.sample-slack", "show_section_notification_chart", "false"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_notification_channel_slack.sample-slack",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -90,3 +103,22 @@ resource "sysdig_monitor_notification_channel_slack" "sample-slack" {
 	show_section_capturing_information = false
 }`, name)
 }
+
+func monitorNotificationChannelSlackWithTemplateConfiguration(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_slack" "sample-slack" {
+	name = "Example Channel %s - Slack"
+	enabled = true
+	url = "https://hooks.slack.cwom/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX"
+	channel = "#sysdig"
+	notify_when_ok = true
+	notify_when_resolved = true
+	template_configuration {
+		template_key = "SLACK_MONITOR_ALERT_NOTIFICATION_TEMPLATE_METADATA_v1"
+		section {
+			name = "MONITOR_ALERT_NOTIFICATION_CHART"
+			show = false
+		}
+	}
+}`, name)
+}
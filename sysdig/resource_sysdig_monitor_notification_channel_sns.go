@@ -9,7 +9,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/spf13/cast"
 )
 
 func resourceSysdigMonitorNotificationChannelSNS() *schema.Resource {
@@ -31,7 +30,7 @@ func resourceSysdigMonitorNotificationChannelSNS() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"topics": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -52,7 +51,7 @@ func resourceSysdigMonitorNotificationChannelSNSCreate(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelSNSFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelSNSFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,7 +82,7 @@ func resourceSysdigMonitorNotificationChannelSNSRead(ctx context.Context, d *sch
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelSNSToResourceData(&nc, d)
+	err = notificationChannelSNSToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -102,7 +101,7 @@ func resourceSysdigMonitorNotificationChannelSNSUpdate(ctx context.Context, d *s
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelSNSFromResourceData(d, teamID)
+	nc, err := notificationChannelSNSFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -133,25 +132,3 @@ func resourceSysdigMonitorNotificationChannelSNSDelete(ctx context.Context, d *s
 
 	return nil
 }
-
-func monitorNotificationChannelSNSFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_AMAZON_SNS
-	nc.Options.SnsTopicARNs = cast.ToStringSlice(d.Get("topics").(*schema.Set).List())
-	return
-}
-
-func monitorNotificationChannelSNSToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("topics", nc.Options.SnsTopicARNs)
-
-	return
-}
@@ -31,7 +31,7 @@ func resourceSysdigMonitorNotificationChannelTeamEmail() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -51,7 +51,7 @@ func resourceSysdigMonitorNotificationChannelTeamEmailCreate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelTeamEmailFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelTeamEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -82,7 +82,7 @@ func resourceSysdigMonitorNotificationChannelTeamEmailRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelTeamEmailToResourceData(&nc, d)
+	err = notificationChannelTeamEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -101,7 +101,7 @@ func resourceSysdigMonitorNotificationChannelTeamEmailUpdate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelTeamEmailFromResourceData(d, teamID)
+	nc, err := notificationChannelTeamEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,25 +132,3 @@ func resourceSysdigMonitorNotificationChannelTeamEmailDelete(ctx context.Context
 
 	return nil
 }
-
-func monitorNotificationChannelTeamEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_TEAM_EMAIL
-	nc.Options.TeamId = d.Get("team_id").(int)
-	return
-}
-
-func monitorNotificationChannelTeamEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("team_id", nc.Options.TeamId)
-
-	return
-}
@@ -30,7 +30,7 @@ func resourceSysdigMonitorNotificationChannelVictorOps() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_VICTOROPS, map[string]*schema.Schema{
 			"api_key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -54,7 +54,7 @@ func resourceSysdigMonitorNotificationChannelVictorOpsCreate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelVictorOpsFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelVictorOpsFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -85,7 +85,7 @@ func resourceSysdigMonitorNotificationChannelVictorOpsRead(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelVictorOpsToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelVictorOpsToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,7 +104,7 @@ func resourceSysdigMonitorNotificationChannelVictorOpsUpdate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelVictorOpsFromResourceData(d, teamID)
+	nc, err := notificationChannelVictorOpsFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,7 +117,7 @@ func resourceSysdigMonitorNotificationChannelVictorOpsUpdate(ctx context.Context
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelVictorOpsRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelVictorOpsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -134,27 +134,3 @@ func resourceSysdigMonitorNotificationChannelVictorOpsDelete(ctx context.Context
 	}
 	return nil
 }
-
-func monitorNotificationChannelVictorOpsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_VICTOROPS
-	nc.Options.APIKey = d.Get("api_key").(string)
-	nc.Options.RoutingKey = d.Get("routing_key").(string)
-	return
-}
-
-func monitorNotificationChannelVictorOpsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("api_key", nc.Options.APIKey)
-	_ = d.Set("routing_key", nc.Options.RoutingKey)
-
-	return
-}
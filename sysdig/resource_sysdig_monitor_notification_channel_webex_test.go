@@ -0,0 +1,72 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelWebex(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelWebexWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_webex.sample_webex1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bot_token"},
+			},
+			{
+				Config: monitorNotificationChannelWebexSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_webex.sample_webex2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bot_token"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelWebexWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "sample_webex1" {
+	name = "Example Channel %s - Webex"
+	enabled = true
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00vXXXXXXXX"
+	bot_token = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func monitorNotificationChannelWebexSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webex" "sample_webex2" {
+	name = "Example Channel %s - Webex"
+	enabled = true
+	room_id = "Y2lzY29zcGFyazovL3VzL1JPT00vXXXXXXXX"
+	bot_token = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
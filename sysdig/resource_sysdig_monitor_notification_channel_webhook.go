@@ -31,7 +31,7 @@ func resourceSysdigMonitorNotificationChannelWebhook() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createMonitorNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_WEBHOOK, map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -65,7 +65,7 @@ func resourceSysdigMonitorNotificationChannelWebhookCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := monitorNotificationChannelWebhookFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -96,7 +96,7 @@ func resourceSysdigMonitorNotificationChannelWebhookRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = monitorNotificationChannelWebhookToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelWebhookToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -115,7 +115,7 @@ func resourceSysdigMonitorNotificationChannelWebhookUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	nc, err := monitorNotificationChannelWebhookFromResourceData(d, teamID)
+	nc, err := notificationChannelWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -128,7 +128,7 @@ func resourceSysdigMonitorNotificationChannelWebhookUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigMonitorNotificationChannelWebhookRead(ctx, d, meta)
 }
 
 func resourceSysdigMonitorNotificationChannelWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -146,34 +146,3 @@ func resourceSysdigMonitorNotificationChannelWebhookDelete(ctx context.Context,
 
 	return nil
 }
-
-func monitorNotificationChannelWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = monitorNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_WEBHOOK
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]interface{})
-	nc.Options.CustomData = d.Get("custom_data").(map[string]interface{})
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
-	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return
-}
-
-func monitorNotificationChannelWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = monitorNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-	_ = d.Set("custom_data", nc.Options.CustomData)
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return
-}
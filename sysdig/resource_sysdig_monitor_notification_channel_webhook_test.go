@@ -28,41 +28,46 @@ func TestAccMonitorNotificationChannelWebhook(t *testing.T) {
 				Config: monitorNotificationChannelWebhookWithName(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_webhook.sample-webhook",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_webhook.sample-webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelWebhookWithNameWithAdditionalheaders(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_webhook.sample-webhook2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_webhook.sample-webhook2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelWebhookSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_webhook.sample-webhook3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_webhook.sample-webhook3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelWebhookSharedWithAllowInsecureConnections(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_webhook.sample-webhook4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_webhook.sample-webhook4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: monitorNotificationChannelWebhookSharedWithCustomData(rText()),
 			},
 			{
-				ResourceName:      "sysdig_monitor_notification_channel_webhook.sample-webhook5",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_monitor_notification_channel_webhook.sample-webhook5",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 		},
 	})
@@ -0,0 +1,70 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationChannelZenduty(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationChannelZendutyWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_zenduty.sample_zenduty1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"routing_key"},
+			},
+			{
+				Config: monitorNotificationChannelZendutySharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_monitor_notification_channel_zenduty.sample_zenduty2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"routing_key"},
+			},
+		},
+	})
+}
+
+func monitorNotificationChannelZendutyWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_zenduty" "sample_zenduty1" {
+	name = "Example Channel %s - Zenduty"
+	enabled = true
+	routing_key = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func monitorNotificationChannelZendutySharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_zenduty" "sample_zenduty2" {
+	name = "Example Channel %s - Zenduty"
+	enabled = true
+	routing_key = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
@@ -0,0 +1,602 @@
+package sysdig
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// monitorNotificationRoutingDepth is the number of levels of nested routes, schemas not being recursive
+const monitorNotificationRoutingDepth = 3
+
+var monitorNotificationRoutingMatcherOperators = []string{"=", "!=", "=~", "!~"}
+
+// resourceSysdigMonitorNotificationRouting routes the alerts to the notification channels with an Alertmanager-like
+// tree, the notification channels of each routed alert being set on apply
+func resourceSysdigMonitorNotificationRouting() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	routingSchema := notificationRoutingChannelsSchema()
+	routingSchema["alert_ids"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
+	routingSchema["route"] = notificationRoutingRouteSchema(monitorNotificationRoutingDepth)
+	routingSchema["alerts"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"notification_channels": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"renotify_every_minutes": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"notify_on_resolve": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"main_threshold": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"warning_threshold": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorNotificationRoutingCreate,
+		UpdateContext: resourceSysdigMonitorNotificationRoutingUpdate,
+		ReadContext:   resourceSysdigMonitorNotificationRoutingRead,
+		DeleteContext: resourceSysdigMonitorNotificationRoutingDelete,
+		CustomizeDiff: resourceSysdigMonitorNotificationRoutingCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: routingSchema,
+	}
+}
+
+// notificationRoutingChannelsSchema are the notification channels of the root of the tree and of each route, with the
+// settings of the notifications sent to them
+func notificationRoutingChannelsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"notification_channel_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"renotify_every_minutes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"notify_on_resolve": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"warning_threshold": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func notificationRoutingRouteSchema(depth int) *schema.Schema {
+	routeSchema := notificationRoutingChannelsSchema()
+	routeSchema["severities"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2.AlertV2SeverityHigh),
+				string(v2.AlertV2SeverityMedium),
+				string(v2.AlertV2SeverityLow),
+				string(v2.AlertV2SeverityInfo),
+			}, false),
+		},
+	}
+	routeSchema["groups"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	routeSchema["matcher"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "=",
+					ValidateFunc: validation.StringInSlice(monitorNotificationRoutingMatcherOperators, false),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	routeSchema["continue"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	if depth > 1 {
+		routeSchema["route"] = notificationRoutingRouteSchema(depth - 1)
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: routeSchema,
+		},
+	}
+}
+
+func getMonitorNotificationRoutingClient(c SysdigClients) (v2.AlertV2Interface, error) {
+	return getAlertV2Client(c)
+}
+
+// resourceSysdigMonitorNotificationRoutingCustomizeDiff computes the notification channels of the alerts with the
+// planned tree, so that the plan shows the alerts whose routing changes, including the ones changed outside Terraform
+func resourceSysdigMonitorNotificationRoutingCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// the channel IDs may only be known once the channels are created
+	if !diff.GetRawConfig().IsWhollyKnown() {
+		return diff.SetNewComputed("alerts")
+	}
+
+	client, err := getMonitorNotificationRoutingClient(meta.(SysdigClients))
+	if err != nil {
+		return err
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return err
+	}
+
+	routed, err := routeMonitorAlerts(diff, alerts)
+	if err != nil {
+		return err
+	}
+
+	expected := monitorNotificationRoutingAlertsToResourceData(routed, nil)
+	if diff.Id() == "" || !monitorNotificationRoutingAlertsEqual(expected, diff.Get("alerts").([]interface{})) {
+		return diff.SetNew("alerts", expected)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorNotificationRoutingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorNotificationRoutingClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	err = syncMonitorNotificationRouting(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorNotificationRoutingRead(ctx, d, meta)
+}
+
+// resourceSysdigMonitorNotificationRoutingRead reports the current notification channels of the alerts routed by the
+// tree, the ones changed since the last apply being routed again by the next one
+func resourceSysdigMonitorNotificationRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorNotificationRoutingClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routed, err := routeMonitorAlerts(d, alerts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := map[int]v2.AlertV2Common{}
+	for _, alert := range alerts {
+		current[alert.ID] = alert
+	}
+	_ = d.Set("alerts", monitorNotificationRoutingAlertsToResourceData(routed, current))
+
+	return nil
+}
+
+func resourceSysdigMonitorNotificationRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorNotificationRoutingClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = syncMonitorNotificationRouting(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorNotificationRoutingRead(ctx, d, meta)
+}
+
+// resourceSysdigMonitorNotificationRoutingDelete leaves the alerts with the notification channels they were routed to
+func resourceSysdigMonitorNotificationRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// syncMonitorNotificationRouting updates the alerts whose notification channels differ from the ones of their route
+func syncMonitorNotificationRouting(ctx context.Context, client v2.AlertV2Interface, d *schema.ResourceData) error {
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return err
+	}
+
+	routed, err := routeMonitorAlerts(d, alerts)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		channels, ok := routed[alert.ID]
+		if !ok {
+			continue
+		}
+		if monitorNotificationRoutingAlertsEqual(
+			monitorNotificationRoutingAlertsToResourceData(map[int]monitorRoutedAlert{alert.ID: channels}, nil),
+			monitorNotificationRoutingAlertsToResourceData(map[int]monitorRoutedAlert{alert.ID: {name: alert.Name, channels: alert.NotificationChannelConfigList}}, nil),
+		) {
+			continue
+		}
+
+		err = client.UpdateAlertV2NotificationChannels(ctx, alert.ID, channels.channels)
+		if err != nil && err != v2.AlertV2NotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type monitorNotificationRoute struct {
+	severities map[string]bool
+	groups     map[string]bool
+	matchers   []monitorNotificationRouteMatcher
+	cont       bool
+	channels   []v2.NotificationChannelConfigV2
+	routes     []monitorNotificationRoute
+}
+
+type monitorNotificationRouteMatcher struct {
+	label    string
+	operator string
+	value    string
+	regexp   *regexp.Regexp
+}
+
+type monitorRoutedAlert struct {
+	name     string
+	channels []v2.NotificationChannelConfigV2
+}
+
+// routeMonitorAlerts returns the notification channels of the alerts routed by the tree, by alert ID. As in
+// Alertmanager, an alert is routed by the first matching route, or by every matching one while they have continue set,
+// and then by the deepest matching route below it. Routes without channels use the channels of their parent.
+// Alerts matching no route get the channels of the root. Alerts left without channels, when the root has none, are not
+// routed. Only the alerts
+// of alert_ids are routed, the other alerts of the team being left as they are.
+func routeMonitorAlerts(d interface{ Get(string) interface{} }, alerts []v2.AlertV2Common) (map[int]monitorRoutedAlert, error) {
+	root := monitorNotificationRoutingChannelsFromMap(map[string]interface{}{
+		"notification_channel_ids": d.Get("notification_channel_ids"),
+		"renotify_every_minutes":   d.Get("renotify_every_minutes"),
+		"notify_on_resolve":        d.Get("notify_on_resolve"),
+		"warning_threshold":        d.Get("warning_threshold"),
+	})
+	routes, err := monitorNotificationRoutesFromList(d.Get("route").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	alertIDs := map[int]bool{}
+	for _, alertID := range d.Get("alert_ids").(*schema.Set).List() {
+		alertIDs[alertID.(int)] = true
+	}
+
+	routed := map[int]monitorRoutedAlert{}
+	for _, alert := range alerts {
+		if !alertIDs[alert.ID] {
+			continue
+		}
+
+		channels, matched := routeMonitorAlert(routes, root, alert)
+		if !matched {
+			channels = root
+		}
+		// a matching route without channels, nor parent or root ones, leaves the alert with its channels rather than
+		// removing all of them
+		if len(channels) == 0 {
+			continue
+		}
+		routed[alert.ID] = monitorRoutedAlert{name: alert.Name, channels: channels}
+	}
+
+	return routed, nil
+}
+
+func routeMonitorAlert(routes []monitorNotificationRoute, parentChannels []v2.NotificationChannelConfigV2, alert v2.AlertV2Common) (channels []v2.NotificationChannelConfigV2, matched bool) {
+	seen := map[int]bool{}
+	for _, route := range routes {
+		if !route.matches(alert) {
+			continue
+		}
+		matched = true
+
+		routeChannels := route.channels
+		if routeChannels == nil {
+			routeChannels = parentChannels
+		}
+		if childChannels, childMatched := routeMonitorAlert(route.routes, routeChannels, alert); childMatched {
+			routeChannels = childChannels
+		}
+		for _, channel := range routeChannels {
+			if !seen[channel.ChannelID] {
+				seen[channel.ChannelID] = true
+				channels = append(channels, channel)
+			}
+		}
+
+		if !route.cont {
+			break
+		}
+	}
+
+	return
+}
+
+func (route monitorNotificationRoute) matches(alert v2.AlertV2Common) bool {
+	if len(route.severities) > 0 && !route.severities[strings.ToLower(alert.Severity)] {
+		return false
+	}
+	if len(route.groups) > 0 && !route.groups[strings.ToLower(alert.Group)] {
+		return false
+	}
+	for _, matcher := range route.matchers {
+		// as in Alertmanager, a missing label matches an empty value
+		value := alert.Labels[matcher.label]
+		switch matcher.operator {
+		case "=":
+			if value != matcher.value {
+				return false
+			}
+		case "!=":
+			if value == matcher.value {
+				return false
+			}
+		case "=~":
+			if !matcher.regexp.MatchString(value) {
+				return false
+			}
+		case "!~":
+			if matcher.regexp.MatchString(value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func monitorNotificationRoutesFromList(list []interface{}) ([]monitorNotificationRoute, error) {
+	var routes []monitorNotificationRoute
+	for _, rawRoute := range list {
+		routeData := rawRoute.(map[string]interface{})
+		route := monitorNotificationRoute{
+			severities: map[string]bool{},
+			groups:     map[string]bool{},
+			cont:       routeData["continue"].(bool),
+			channels:   monitorNotificationRoutingChannelsFromMap(routeData),
+		}
+		for _, severity := range routeData["severities"].(*schema.Set).List() {
+			route.severities[severity.(string)] = true
+		}
+		// the groups are stored lowercase by the alerts
+		for _, group := range routeData["groups"].(*schema.Set).List() {
+			route.groups[strings.ToLower(group.(string))] = true
+		}
+		for _, rawMatcher := range routeData["matcher"].([]interface{}) {
+			matcherData := rawMatcher.(map[string]interface{})
+			matcher := monitorNotificationRouteMatcher{
+				label:    matcherData["label"].(string),
+				operator: matcherData["operator"].(string),
+				value:    matcherData["value"].(string),
+			}
+			if matcher.operator == "=~" || matcher.operator == "!~" {
+				// the regular expressions are anchored, as in Alertmanager
+				var err error
+				matcher.regexp, err = regexp.Compile("^(?:" + matcher.value + ")$")
+				if err != nil {
+					return nil, err
+				}
+			}
+			route.matchers = append(route.matchers, matcher)
+		}
+		if children, ok := routeData["route"]; ok {
+			var err error
+			route.routes, err = monitorNotificationRoutesFromList(children.([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// monitorNotificationRoutingChannelsFromMap returns nil when no channel is set, so that the channels of the parent
+// route are used
+func monitorNotificationRoutingChannelsFromMap(data map[string]interface{}) []v2.NotificationChannelConfigV2 {
+	channelIDs := data["notification_channel_ids"].(*schema.Set).List()
+	if len(channelIDs) == 0 {
+		return nil
+	}
+
+	thresholds := []string{"MAIN"}
+	if data["warning_threshold"].(bool) {
+		thresholds = append(thresholds, "WARNING")
+	}
+
+	var channels []v2.NotificationChannelConfigV2
+	for _, channelID := range channelIDs {
+		channel := v2.NotificationChannelConfigV2{
+			ChannelID: channelID.(int),
+			OverrideOptions: v2.NotificationChannelOptionsV2{
+				NotifyOnResolve: data["notify_on_resolve"].(bool),
+				Thresholds:      thresholds,
+			},
+		}
+		if minutes := data["renotify_every_minutes"].(int); minutes != 0 {
+			seconds := minutesToSeconds(minutes)
+			channel.OverrideOptions.ReNotifyEverySec = &seconds
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// monitorNotificationRoutingAlertsToResourceData lists the routed alerts by ID, with their channels by ID. When the
+// current alerts are given, their current channels are listed instead of the routed ones.
+func monitorNotificationRoutingAlertsToResourceData(routed map[int]monitorRoutedAlert, current map[int]v2.AlertV2Common) []interface{} {
+	alertIDs := make([]int, 0, len(routed))
+	for alertID := range routed {
+		alertIDs = append(alertIDs, alertID)
+	}
+	sort.Ints(alertIDs)
+
+	alertsData := []interface{}{}
+	for _, alertID := range alertIDs {
+		alert := routed[alertID]
+		if current != nil {
+			alert = monitorRoutedAlert{name: current[alertID].Name, channels: current[alertID].NotificationChannelConfigList}
+		}
+
+		channels := append([]v2.NotificationChannelConfigV2{}, alert.channels...)
+		sort.Slice(channels, func(i, j int) bool { return channels[i].ChannelID < channels[j].ChannelID })
+
+		channelsData := []interface{}{}
+		for _, channel := range channels {
+			channelData := map[string]interface{}{
+				"id":                     channel.ChannelID,
+				"renotify_every_minutes": 0,
+				"notify_on_resolve":      channel.OverrideOptions.NotifyOnResolve,
+				"main_threshold":         true,
+				"warning_threshold":      false,
+			}
+			if channel.OverrideOptions.ReNotifyEverySec != nil {
+				channelData["renotify_every_minutes"] = secondsToMinutes(*channel.OverrideOptions.ReNotifyEverySec)
+			}
+			if channel.OverrideOptions.Thresholds != nil {
+				channelData["main_threshold"] = false
+				for _, threshold := range channel.OverrideOptions.Thresholds {
+					switch threshold {
+					case "MAIN":
+						channelData["main_threshold"] = true
+					case "WARNING":
+						channelData["warning_threshold"] = true
+					}
+				}
+			}
+			channelsData = append(channelsData, channelData)
+		}
+
+		alertsData = append(alertsData, map[string]interface{}{
+			"id":                    alertID,
+			"name":                  alert.name,
+			"notification_channels": channelsData,
+		})
+	}
+	return alertsData
+}
+
+// monitorNotificationRoutingAlertsEqual compares the channels of the alerts, regardless of their names
+func monitorNotificationRoutingAlertsEqual(expected, current []interface{}) bool {
+	if len(expected) != len(current) {
+		return false
+	}
+	for i := range expected {
+		expectedAlert := expected[i].(map[string]interface{})
+		currentAlert := current[i].(map[string]interface{})
+		if expectedAlert["id"] != currentAlert["id"] {
+			return false
+		}
+		expectedChannels := expectedAlert["notification_channels"].([]interface{})
+		currentChannels := currentAlert["notification_channels"].([]interface{})
+		if len(expectedChannels) != len(currentChannels) {
+			return false
+		}
+		for j := range expectedChannels {
+			expectedChannel := expectedChannels[j].(map[string]interface{})
+			currentChannel := currentChannels[j].(map[string]interface{})
+			for _, key := range []string{"id", "renotify_every_minutes", "notify_on_resolve", "main_threshold", "warning_threshold"} {
+				if expectedChannel[key] != currentChannel[key] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
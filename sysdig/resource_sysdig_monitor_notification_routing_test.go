@@ -0,0 +1,96 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorNotificationRouting(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigMonitorApiTokenEnv, SysdigIBMMonitorAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorNotificationRouting(rText, "high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_routing.sample", "alerts.#", "2"),
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_routing.sample", "alerts.0.id", "sysdig_monitor_alert_v2_prometheus.high", "id"),
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_routing.sample", "alerts.0.notification_channels.0.id", "sysdig_monitor_notification_channel_email.pager", "id"),
+					resource.TestCheckResourceAttr("sysdig_monitor_notification_routing.sample", "alerts.0.notification_channels.0.renotify_every_minutes", "30"),
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_routing.sample", "alerts.1.notification_channels.0.id", "sysdig_monitor_notification_channel_email.default", "id"),
+				),
+			},
+			{
+				Config: monitorNotificationRouting(rText, "low"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_routing.sample", "alerts.0.notification_channels.0.id", "sysdig_monitor_notification_channel_email.default", "id"),
+					resource.TestCheckResourceAttrPair("sysdig_monitor_notification_routing.sample", "alerts.1.notification_channels.0.id", "sysdig_monitor_notification_channel_email.pager", "id"),
+				),
+			},
+		},
+	})
+}
+
+func monitorNotificationRouting(name, pagedSeverity string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "default" {
+	name = "%[1]s - default"
+	recipients = ["root@localhost.com"]
+}
+
+resource "sysdig_monitor_notification_channel_email" "pager" {
+	name = "%[1]s - pager"
+	recipients = ["oncall@localhost.com"]
+}
+
+resource "sysdig_monitor_alert_v2_prometheus" "high" {
+	name = "TERRAFORM TEST - ROUTING HIGH %[1]s"
+	severity = "high"
+	query = "avg(avg_over_time(sysdig_host_cpu_used_percent[59s])) > 95"
+	trigger_after_minutes = 10
+
+	lifecycle {
+		ignore_changes = [notification_channels]
+	}
+}
+
+resource "sysdig_monitor_alert_v2_prometheus" "low" {
+	name = "TERRAFORM TEST - ROUTING LOW %[1]s"
+	severity = "low"
+	query = "avg(avg_over_time(sysdig_host_cpu_used_percent[59s])) > 80"
+	trigger_after_minutes = 10
+
+	lifecycle {
+		ignore_changes = [notification_channels]
+	}
+
+	# created after the high severity alert, so that it is listed second
+	depends_on = [sysdig_monitor_alert_v2_prometheus.high]
+}
+
+resource "sysdig_monitor_notification_routing" "sample" {
+	alert_ids = [sysdig_monitor_alert_v2_prometheus.high.id, sysdig_monitor_alert_v2_prometheus.low.id]
+	notification_channel_ids = [sysdig_monitor_notification_channel_email.default.id]
+
+	route {
+		severities = ["%[2]s"]
+		notification_channel_ids = [sysdig_monitor_notification_channel_email.pager.id]
+		renotify_every_minutes = 30
+	}
+}
+`, name, pagedSeverity)
+}
@@ -0,0 +1,97 @@
+//go:build unit
+
+package sysdig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+func TestRouteMonitorAlerts(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSysdigMonitorNotificationRouting().Schema, map[string]interface{}{
+		"alert_ids": []interface{}{1, 2, 3, 4},
+		"route": []interface{}{
+			map[string]interface{}{
+				"severities":               []interface{}{"high"},
+				"notification_channel_ids": []interface{}{1},
+				"renotify_every_minutes":   30,
+				"continue":                 true,
+				"route": []interface{}{
+					map[string]interface{}{
+						"matcher": []interface{}{
+							map[string]interface{}{"label": "team", "operator": "=~", "value": "pay.*"},
+						},
+						"notification_channel_ids": []interface{}{2},
+					},
+				},
+			},
+			map[string]interface{}{
+				"groups":                   []interface{}{"Kubernetes"},
+				"notification_channel_ids": []interface{}{3},
+				"notify_on_resolve":        false,
+			},
+			map[string]interface{}{
+				"groups":                   []interface{}{"kubernetes"},
+				"notification_channel_ids": []interface{}{4},
+			},
+		},
+	})
+
+	alerts := []v2.AlertV2Common{
+		{ID: 1, Name: "paging", Severity: "high", Labels: map[string]string{"team": "payments"}},
+		{ID: 2, Name: "high", Severity: "high", Group: "kubernetes"},
+		{ID: 3, Name: "kubernetes", Severity: "low", Group: "kubernetes"},
+		{ID: 4, Name: "unrouted", Severity: "low"},
+		{ID: 5, Name: "unselected", Severity: "high"},
+	}
+
+	routed, err := routeMonitorAlerts(d, alerts)
+	assert.NoError(t, err)
+
+	channelIDs := map[int][]int{}
+	for alertID, alert := range routed {
+		for _, channel := range alert.channels {
+			channelIDs[alertID] = append(channelIDs[alertID], channel.ChannelID)
+		}
+	}
+	assert.Equal(t, map[int][]int{1: {2}, 2: {1, 3}, 3: {3}}, channelIDs)
+
+	assert.Equal(t, 1800, *routed[2].channels[0].OverrideOptions.ReNotifyEverySec)
+	assert.Nil(t, routed[1].channels[0].OverrideOptions.ReNotifyEverySec)
+	assert.False(t, routed[3].channels[0].OverrideOptions.NotifyOnResolve)
+	assert.Equal(t, []string{"MAIN"}, routed[3].channels[0].OverrideOptions.Thresholds)
+
+	_ = d.Set("notification_channel_ids", []interface{}{5})
+	_ = d.Set("alert_ids", []interface{}{3, 4})
+	routed, err = routeMonitorAlerts(d, alerts)
+	assert.NoError(t, err)
+	assert.Len(t, routed, 2)
+	assert.Equal(t, 5, routed[4].channels[0].ChannelID)
+
+	// a matching route with only nested routes and no channels to fall back on does not route the alerts
+	d = schema.TestResourceDataRaw(t, resourceSysdigMonitorNotificationRouting().Schema, map[string]interface{}{
+		"alert_ids": []interface{}{1, 2},
+		"route": []interface{}{
+			map[string]interface{}{
+				"severities": []interface{}{"high"},
+				"route": []interface{}{
+					map[string]interface{}{
+						"matcher": []interface{}{
+							map[string]interface{}{"label": "team", "operator": "=", "value": "payments"},
+						},
+						"notification_channel_ids": []interface{}{2},
+					},
+				},
+			},
+		},
+	})
+	routed, err = routeMonitorAlerts(d, alerts)
+	assert.NoError(t, err)
+	assert.Len(t, routed, 1)
+	assert.Equal(t, 2, routed[1].channels[0].ChannelID)
+	assert.NotContains(t, routed, 2)
+}
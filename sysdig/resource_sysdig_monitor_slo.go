@@ -0,0 +1,659 @@
+package sysdig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	sloTriggerPage   = "page"
+	sloTriggerTicket = "ticket"
+)
+
+// sloBurnRateWindow is one of the multi-window, multi-burn-rate alerting windows
+// recommended by the Google SRE workbook: the alert fires when both the long and
+// the short window consume the error budget faster than the given fraction allows.
+type sloBurnRateWindow struct {
+	trigger        string
+	longWindow     time.Duration
+	shortWindow    time.Duration
+	budgetConsumed float64
+}
+
+var sloBurnRateWindows = []sloBurnRateWindow{
+	{trigger: sloTriggerPage, longWindow: time.Hour, shortWindow: 5 * time.Minute, budgetConsumed: 0.02},
+	{trigger: sloTriggerPage, longWindow: 6 * time.Hour, shortWindow: 30 * time.Minute, budgetConsumed: 0.05},
+	{trigger: sloTriggerTicket, longWindow: 24 * time.Hour, shortWindow: 2 * time.Hour, budgetConsumed: 0.1},
+	{trigger: sloTriggerTicket, longWindow: 72 * time.Hour, shortWindow: 6 * time.Hour, budgetConsumed: 0.1},
+}
+
+var sloTriggerDefaultSeverities = map[string]v2.AlertV2Severity{
+	sloTriggerPage:   v2.AlertV2SeverityHigh,
+	sloTriggerTicket: v2.AlertV2SeverityMedium,
+}
+
+func resourceSysdigMonitorSLO() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorSLOCreate,
+		UpdateContext: resourceSysdigMonitorSLOUpdate,
+		ReadContext:   resourceSysdigMonitorSLORead,
+		DeleteContext: resourceSysdigMonitorSLODelete,
+		CustomizeDiff: resourceSysdigMonitorSLOCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"good_query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"total_query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"objective": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+			"compliance_window_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			sloTriggerPage:   sloTriggerSchema(sloTriggerPage),
+			sloTriggerTicket: sloTriggerSchema(sloTriggerTicket),
+			"create_dashboard": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"error_budget": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"dashboard_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"burn_rate_alert": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"long_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_window": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"burn_rate": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"notification_channel_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func sloTriggerSchema(trigger string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		AtLeastOneOf: []string{sloTriggerPage, sloTriggerTicket},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"notification_channel_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+				},
+				"severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      string(sloTriggerDefaultSeverities[trigger]),
+					ValidateFunc: validation.StringInSlice(AlertV2SeverityValues(), true),
+				},
+				"renotify_every_minutes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"notify_on_resolve": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func getMonitorSLOClient(c SysdigClients) (v2.MonitorCommon, error) {
+	var client v2.MonitorCommon
+	var err error
+	switch c.GetClientType() {
+	case IBMMonitor:
+		client, err = c.ibmMonitorClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		client, err = c.sysdigMonitorClientV2()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// resourceSysdigMonitorSLOCustomizeDiff forces an update whenever the alerts or the dashboard
+// managed by the SLO were changed or removed outside of Terraform, since they are only
+// exposed as computed attributes.
+func resourceSysdigMonitorSLOCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if diff.Get("objective").(float64) >= 100 {
+		return errors.New("objective must be lower than 100")
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	expected := monitorSLOBurnRateAlertsToResourceData(monitorSLOBurnRateAlertsFromResourceData(diff))
+	current := diff.Get("burn_rate_alert").([]interface{})
+	if !monitorSLOBurnRateAlertsEqual(expected, current) {
+		if err := diff.SetNewComputed("burn_rate_alert"); err != nil {
+			return err
+		}
+	}
+
+	if diff.Get("create_dashboard").(bool) != (diff.Get("dashboard_id").(int) != 0) {
+		if err := diff.SetNewComputed("dashboard_id"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorSLOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorSLOClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	err = syncMonitorSLO(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorSLORead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorSLOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorSLOClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = syncMonitorSLO(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSysdigMonitorSLORead(ctx, d, meta)
+}
+
+func resourceSysdigMonitorSLORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorSLOClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var burnRateAlerts []interface{}
+	for _, rawAlert := range d.Get("burn_rate_alert").([]interface{}) {
+		alertData := rawAlert.(map[string]interface{})
+		alert, err := client.GetAlertV2Prometheus(ctx, alertData["id"].(int))
+		if err != nil {
+			if err == v2.AlertV2NotFound {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		var channelIDs []int
+		for _, channel := range alert.NotificationChannelConfigList {
+			channelIDs = append(channelIDs, channel.ChannelID)
+		}
+		sort.Ints(channelIDs)
+
+		alertData["name"] = alert.Name
+		alertData["query"] = alert.Config.Query
+		alertData["severity"] = alert.Severity
+		alertData["enabled"] = alert.Enabled
+		alertData["notification_channel_ids"] = channelIDs
+		burnRateAlerts = append(burnRateAlerts, alertData)
+	}
+	_ = d.Set("burn_rate_alert", burnRateAlerts)
+
+	if dashboardID := d.Get("dashboard_id").(int); dashboardID != 0 {
+		// the dashboard client does not distinguish a missing dashboard from other errors
+		if _, err := client.GetDashboard(ctx, dashboardID); err != nil {
+			_ = d.Set("dashboard_id", 0)
+		}
+	}
+
+	_ = d.Set("error_budget", monitorSLOErrorBudget(d.Get("objective").(float64)))
+
+	return nil
+}
+
+func resourceSysdigMonitorSLODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getMonitorSLOClient(meta.(SysdigClients))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, rawAlert := range d.Get("burn_rate_alert").([]interface{}) {
+		err = client.DeleteAlertV2Prometheus(ctx, rawAlert.(map[string]interface{})["id"].(int))
+		if err != nil && err != v2.AlertV2NotFound {
+			return diag.FromErr(err)
+		}
+	}
+
+	if dashboardID := d.Get("dashboard_id").(int); dashboardID != 0 {
+		err = client.DeleteDashboard(ctx, dashboardID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// syncMonitorSLO creates, updates and deletes the burn rate alerts and the dashboard of the SLO
+// so that they match the configuration.
+func syncMonitorSLO(ctx context.Context, client v2.MonitorCommon, d *schema.ResourceData) error {
+	previousAlerts, _ := d.GetChange("burn_rate_alert")
+	previousIDs := map[string]int{}
+	for _, rawAlert := range previousAlerts.([]interface{}) {
+		alertData := rawAlert.(map[string]interface{})
+		previousIDs[monitorSLOBurnRateAlertKey(alertData)] = alertData["id"].(int)
+	}
+
+	var syncedAlerts []monitorSLOBurnRateAlert
+	for _, burnRateAlert := range monitorSLOBurnRateAlertsFromResourceData(d) {
+		alert, err := upsertMonitorSLOBurnRateAlert(ctx, client, previousIDs[burnRateAlert.key()], burnRateAlert.alert)
+		if err != nil {
+			// keep track of both the alerts synced so far and the ones still to be synced, so none is leaked
+			alertsData := monitorSLOBurnRateAlertsToResourceData(syncedAlerts)
+			for _, rawAlert := range previousAlerts.([]interface{}) {
+				if _, ok := previousIDs[monitorSLOBurnRateAlertKey(rawAlert.(map[string]interface{}))]; ok {
+					alertsData = append(alertsData, rawAlert)
+				}
+			}
+			_ = d.Set("burn_rate_alert", alertsData)
+			return err
+		}
+		burnRateAlert.alert = alert
+		syncedAlerts = append(syncedAlerts, burnRateAlert)
+		delete(previousIDs, burnRateAlert.key())
+	}
+
+	for _, staleID := range previousIDs {
+		err := client.DeleteAlertV2Prometheus(ctx, staleID)
+		if err != nil && err != v2.AlertV2NotFound {
+			return err
+		}
+	}
+
+	_ = d.Set("burn_rate_alert", monitorSLOBurnRateAlertsToResourceData(syncedAlerts))
+
+	dashboardID, _ := d.GetChange("dashboard_id")
+	if !d.Get("create_dashboard").(bool) {
+		if dashboardID.(int) != 0 {
+			err := client.DeleteDashboard(ctx, dashboardID.(int))
+			if err != nil {
+				return err
+			}
+		}
+		_ = d.Set("dashboard_id", 0)
+		return nil
+	}
+
+	dashboard, err := monitorSLODashboard(d)
+	if err != nil {
+		return err
+	}
+
+	if dashboardID.(int) != 0 {
+		if existing, err := client.GetDashboard(ctx, dashboardID.(int)); err == nil {
+			dashboard.ID = existing.ID
+			dashboard.Version = existing.Version
+			dashboard, err = client.UpdateDashboard(ctx, dashboard)
+			if err != nil {
+				return err
+			}
+			_ = d.Set("dashboard_id", dashboard.ID)
+			return nil
+		}
+	}
+
+	dashboard, err = client.CreateDashboard(ctx, dashboard)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("dashboard_id", dashboard.ID)
+
+	return nil
+}
+
+func upsertMonitorSLOBurnRateAlert(ctx context.Context, client v2.MonitorCommon, alertID int, alert v2.AlertV2Prometheus) (v2.AlertV2Prometheus, error) {
+	if alertID != 0 {
+		existing, err := client.GetAlertV2Prometheus(ctx, alertID)
+		if err == nil {
+			alert.ID = existing.ID
+			alert.Version = existing.Version
+			alert.TeamID = existing.TeamID
+			return client.UpdateAlertV2Prometheus(ctx, alert)
+		}
+		if err != v2.AlertV2NotFound {
+			return v2.AlertV2Prometheus{}, err
+		}
+	}
+
+	return client.CreateAlertV2Prometheus(ctx, alert)
+}
+
+type monitorSLOBurnRateAlert struct {
+	window   sloBurnRateWindow
+	burnRate float64
+	alert    v2.AlertV2Prometheus
+}
+
+func (a monitorSLOBurnRateAlert) key() string {
+	return a.window.trigger + "/" + promqlDuration(a.window.longWindow)
+}
+
+func monitorSLOBurnRateAlertKey(alertData map[string]interface{}) string {
+	return alertData["trigger"].(string) + "/" + alertData["long_window"].(string)
+}
+
+func monitorSLOErrorBudget(objective float64) float64 {
+	errorBudget, _ := strconv.ParseFloat(strconv.FormatFloat(1-objective/100, 'g', 10, 64), 64)
+	return errorBudget
+}
+
+// promqlDuration formats a duration using the largest PromQL time unit that represents it exactly.
+func promqlDuration(duration time.Duration) string {
+	switch {
+	case duration%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", duration/(24*time.Hour))
+	case duration%time.Hour == 0:
+		return fmt.Sprintf("%dh", duration/time.Hour)
+	default:
+		return fmt.Sprintf("%dm", duration/time.Minute)
+	}
+}
+
+func monitorSLOErrorRatioQuery(goodQuery, totalQuery string, window time.Duration) string {
+	return fmt.Sprintf("(1 - sum(rate(%[1]s[%[3]s])) / sum(rate(%[2]s[%[3]s])))", goodQuery, totalQuery, promqlDuration(window))
+}
+
+func monitorSLOBurnRateAlertsFromResourceData(d interface{ Get(string) interface{} }) []monitorSLOBurnRateAlert {
+	name := d.Get("name").(string)
+	goodQuery := d.Get("good_query").(string)
+	totalQuery := d.Get("total_query").(string)
+	errorBudget := monitorSLOErrorBudget(d.Get("objective").(float64))
+	complianceWindow := time.Duration(d.Get("compliance_window_days").(int)) * 24 * time.Hour
+
+	var burnRateAlerts []monitorSLOBurnRateAlert
+	for _, window := range sloBurnRateWindows {
+		triggerList := d.Get(window.trigger).([]interface{})
+		if len(triggerList) == 0 {
+			continue
+		}
+		trigger := map[string]interface{}{}
+		if triggerList[0] != nil {
+			trigger = triggerList[0].(map[string]interface{})
+		}
+		severity, ok := trigger["severity"].(string)
+		if !ok || severity == "" {
+			severity = string(sloTriggerDefaultSeverities[window.trigger])
+		}
+
+		burnRate, _ := strconv.ParseFloat(strconv.FormatFloat(window.budgetConsumed*complianceWindow.Hours()/window.longWindow.Hours(), 'g', 10, 64), 64)
+		threshold := strconv.FormatFloat(burnRate*errorBudget, 'g', 10, 64)
+
+		alert := v2.AlertV2Prometheus{
+			AlertV2Common: v2.AlertV2Common{
+				Name: fmt.Sprintf("%s - %s burn rate %s/%s", name, window.trigger,
+					promqlDuration(window.longWindow), promqlDuration(window.shortWindow)),
+				Description:                d.Get("description").(string),
+				Type:                       string(v2.AlertV2TypePrometheus),
+				Severity:                   strings.ToLower(severity),
+				Enabled:                    d.Get("enabled").(bool),
+				CustomNotificationTemplate: &v2.CustomNotificationTemplateV2{},
+				Links:                      []v2.AlertLinkV2{},
+			},
+			DurationSec: minutesToSeconds(1),
+			Config: v2.AlertV2ConfigPrometheus{
+				Query: fmt.Sprintf("%s > %s\nand\n%s > %s",
+					monitorSLOErrorRatioQuery(goodQuery, totalQuery, window.longWindow), threshold,
+					monitorSLOErrorRatioQuery(goodQuery, totalQuery, window.shortWindow), threshold),
+			},
+		}
+
+		alert.NotificationChannelConfigList = []v2.NotificationChannelConfigV2{}
+		if channelIDs, ok := trigger["notification_channel_ids"].(*schema.Set); ok {
+			for _, channelID := range channelIDs.List() {
+				channel := v2.NotificationChannelConfigV2{
+					ChannelID: channelID.(int),
+				}
+				if renotifyEveryMinutes, ok := trigger["renotify_every_minutes"].(int); ok && renotifyEveryMinutes != 0 {
+					s := minutesToSeconds(renotifyEveryMinutes)
+					channel.OverrideOptions.ReNotifyEverySec = &s
+				}
+				notifyOnResolve, _ := trigger["notify_on_resolve"].(bool)
+				channel.OverrideOptions.NotifyOnResolve = notifyOnResolve
+				channel.OverrideOptions.Thresholds = []string{"MAIN"}
+				alert.NotificationChannelConfigList = append(alert.NotificationChannelConfigList, channel)
+			}
+		}
+
+		burnRateAlerts = append(burnRateAlerts, monitorSLOBurnRateAlert{
+			window:   window,
+			burnRate: burnRate,
+			alert:    alert,
+		})
+	}
+
+	return burnRateAlerts
+}
+
+func monitorSLOBurnRateAlertsToResourceData(burnRateAlerts []monitorSLOBurnRateAlert) []interface{} {
+	var result []interface{}
+	for _, burnRateAlert := range burnRateAlerts {
+		var channelIDs []int
+		for _, channel := range burnRateAlert.alert.NotificationChannelConfigList {
+			channelIDs = append(channelIDs, channel.ChannelID)
+		}
+		sort.Ints(channelIDs)
+
+		result = append(result, map[string]interface{}{
+			"id":                       burnRateAlert.alert.ID,
+			"name":                     burnRateAlert.alert.Name,
+			"trigger":                  burnRateAlert.window.trigger,
+			"long_window":              promqlDuration(burnRateAlert.window.longWindow),
+			"short_window":             promqlDuration(burnRateAlert.window.shortWindow),
+			"burn_rate":                burnRateAlert.burnRate,
+			"query":                    burnRateAlert.alert.Config.Query,
+			"severity":                 burnRateAlert.alert.Severity,
+			"enabled":                  burnRateAlert.alert.Enabled,
+			"notification_channel_ids": channelIDs,
+		})
+	}
+	return result
+}
+
+// monitorSLOBurnRateAlertsEqual compares the expected alerts with the ones in the state, ignoring their IDs.
+func monitorSLOBurnRateAlertsEqual(expected, current []interface{}) bool {
+	if len(expected) != len(current) {
+		return false
+	}
+
+	for i := range expected {
+		expectedAlert := expected[i].(map[string]interface{})
+		currentAlert := current[i].(map[string]interface{})
+		for _, key := range []string{"name", "trigger", "long_window", "short_window", "query", "severity", "enabled"} {
+			if expectedAlert[key] != currentAlert[key] {
+				return false
+			}
+		}
+
+		var currentChannelIDs []int
+		for _, channelID := range currentAlert["notification_channel_ids"].([]interface{}) {
+			currentChannelIDs = append(currentChannelIDs, channelID.(int))
+		}
+		if !reflect.DeepEqual(expectedAlert["notification_channel_ids"], currentChannelIDs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func monitorSLODashboard(d *schema.ResourceData) (*v2.Dashboard, error) {
+	name := d.Get("name").(string)
+	goodQuery := d.Get("good_query").(string)
+	totalQuery := d.Get("total_query").(string)
+	errorBudget := strconv.FormatFloat(monitorSLOErrorBudget(d.Get("objective").(float64)), 'g', 10, 64)
+	complianceWindow := time.Duration(d.Get("compliance_window_days").(int)) * 24 * time.Hour
+	complianceErrorRatio := monitorSLOErrorRatioQuery(goodQuery, totalQuery, complianceWindow)
+
+	dashboard := v2.NewDashboard(fmt.Sprintf("SLO: %s", name), d.Get("description").(string))
+
+	sli := newNumberPanel("SLI", fmt.Sprintf("Ratio of good events over the last %s", promqlDuration(complianceWindow)))
+	if _, err := sli.WithLayout(0, 0, 8, 6); err != nil {
+		return nil, err
+	}
+	if _, err := sli.AddQueries(v2.NewPromqlQuery(
+		fmt.Sprintf("100 * (1 - %s)", complianceErrorRatio), sli, v2.DisplayInfo{Type: "lines"},
+	).WithPercentFormat(nil)); err != nil {
+		return nil, err
+	}
+
+	budget := newNumberPanel("Error budget remaining", fmt.Sprintf("Error budget left over the last %s", promqlDuration(complianceWindow)))
+	if _, err := budget.WithLayout(8, 0, 8, 6); err != nil {
+		return nil, err
+	}
+	if _, err := budget.AddQueries(v2.NewPromqlQuery(
+		fmt.Sprintf("100 * (1 - %s / %s)", complianceErrorRatio, errorBudget), budget, v2.DisplayInfo{Type: "lines"},
+	).WithPercentFormat(nil)); err != nil {
+		return nil, err
+	}
+
+	objective := newNumberPanel("Objective", "")
+	if _, err := objective.WithLayout(16, 0, 8, 6); err != nil {
+		return nil, err
+	}
+	if _, err := objective.AddQueries(v2.NewPromqlQuery(
+		fmt.Sprintf("vector(%s)", strconv.FormatFloat(d.Get("objective").(float64), 'g', 10, 64)), objective, v2.DisplayInfo{Type: "lines"},
+	).WithPercentFormat(nil)); err != nil {
+		return nil, err
+	}
+
+	burnRate := newTimechartPanel("Burn rate", "Speed at which the error budget is consumed, 1 means it would be exhausted exactly at the end of the compliance window", defaultLegendConfiguration())
+	if _, err := burnRate.WithLayout(0, 6, 24, 8); err != nil {
+		return nil, err
+	}
+	var burnRateQueries []*v2.AdvancedQueries
+	for _, window := range []time.Duration{5 * time.Minute, time.Hour, 6 * time.Hour} {
+		burnRateQueries = append(burnRateQueries, v2.NewPromqlQuery(
+			fmt.Sprintf("%s / %s", monitorSLOErrorRatioQuery(goodQuery, totalQuery, window), errorBudget), burnRate,
+			v2.DisplayInfo{DisplayName: promqlDuration(window), TimeSeriesDisplayNameTemplate: promqlDuration(window), Type: "lines"},
+		).WithNumberFormat(nil))
+	}
+	if _, err := burnRate.AddQueries(burnRateQueries...); err != nil {
+		return nil, err
+	}
+
+	dashboard.AddPanels(sli, budget, objective, burnRate)
+	dashboard.ScopeExpressionList = []*v2.ScopeExpressionList{}
+
+	return dashboard, nil
+}
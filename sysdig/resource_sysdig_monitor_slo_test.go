@@ -0,0 +1,121 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_ibm_monitor
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccMonitorSLO(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: monitorSLOWithPage(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.#", "2"),
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.0.burn_rate", "14.4"),
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.1.burn_rate", "6"),
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "dashboard_id", "0"),
+				),
+			},
+			{
+				Config: monitorSLOWithPageAndTicket(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.#", "4"),
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.2.severity", "low"),
+					resource.TestCheckResourceAttrSet("sysdig_monitor_slo.sample", "burn_rate_alert.3.id"),
+				),
+			},
+			{
+				Config: monitorSLOWithDashboard(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.#", "2"),
+					resource.TestCheckResourceAttr("sysdig_monitor_slo.sample", "burn_rate_alert.0.trigger", "ticket"),
+					resource.TestCheckResourceAttrSet("sysdig_monitor_slo.sample", "dashboard_id"),
+				),
+			},
+		},
+	})
+}
+
+func monitorSLOWithPage(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "sample" {
+	name = "Example Channel %s - Email"
+	recipients = ["foo@localhost.com"]
+	enabled = false
+	send_test_notification = false
+}
+
+resource "sysdig_monitor_slo" "sample" {
+	name = "TERRAFORM TEST - SLO %s"
+	good_query = "sysdig_container_net_http_request_count{net_http_statuscode!~\"5..\"}"
+	total_query = "sysdig_container_net_http_request_count"
+	objective = 99.9
+	enabled = false
+
+	page {
+		notification_channel_ids = [sysdig_monitor_notification_channel_email.sample.id]
+	}
+}`, name, name)
+}
+
+func monitorSLOWithPageAndTicket(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_email" "sample" {
+	name = "Example Channel %s - Email"
+	recipients = ["foo@localhost.com"]
+	enabled = false
+	send_test_notification = false
+}
+
+resource "sysdig_monitor_slo" "sample" {
+	name = "TERRAFORM TEST - SLO %s"
+	description = "HTTP availability"
+	good_query = "sysdig_container_net_http_request_count{net_http_statuscode!~\"5..\"}"
+	total_query = "sysdig_container_net_http_request_count"
+	objective = 99.5
+	compliance_window_days = 28
+	enabled = false
+
+	page {
+		notification_channel_ids = [sysdig_monitor_notification_channel_email.sample.id]
+		renotify_every_minutes = 30
+	}
+
+	ticket {
+		notification_channel_ids = [sysdig_monitor_notification_channel_email.sample.id]
+		severity = "low"
+		notify_on_resolve = false
+	}
+}`, name, name)
+}
+
+func monitorSLOWithDashboard(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_slo" "sample" {
+	name = "TERRAFORM TEST - SLO %s"
+	good_query = "sysdig_container_net_http_request_count{net_http_statuscode!~\"5..\"}"
+	total_query = "sysdig_container_net_http_request_count"
+	objective = 99
+	enabled = false
+	create_dashboard = true
+
+	ticket {}
+}`, name)
+}
@@ -0,0 +1,603 @@
+package sysdig
+
+import (
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spf13/cast"
+)
+
+// createNotificationChannelSchema and the builders below are shared by the Sysdig Monitor and Sysdig Secure
+// notification channels, which only differ by the client used to manage them
+func createNotificationChannelSchema(original map[string]*schema.Schema) map[string]*schema.Schema {
+	notificationChannelSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"share_with_current_team": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"shared_with_teams", "shared_globally"},
+		},
+		"shared_with_teams": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			Elem:          &schema.Schema{Type: schema.TypeInt},
+			ConflictsWith: []string{"share_with_current_team", "shared_globally"},
+		},
+		"shared_globally": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"share_with_current_team", "shared_with_teams"},
+		},
+		"notify_when_ok": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"notify_when_resolved": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"send_test_notification": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+
+	for k, v := range original {
+		notificationChannelSchema[k] = v
+	}
+
+	return notificationChannelSchema
+}
+
+// notificationChannelFromResourceData shares the channel with the current team, with the teams of shared_with_teams,
+// or with all the teams when neither is set. The teams the channel is shared with are kept as they are when none of
+// the sharing attributes is configured, and are only cleared by share_with_current_team or shared_globally.
+func notificationChannelFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	var tID *int
+	shareWithCurrentTeam := d.Get("share_with_current_team").(bool)
+	if shareWithCurrentTeam {
+		tID = &teamID
+	}
+	teams := d.Get("shared_with_teams").(*schema.Set).List()
+	sharedGlobally := rawConfigAttr(d.GetRawConfig(), "shared_globally")
+	shareGlobally := !sharedGlobally.IsNull() && sharedGlobally.IsKnown() && sharedGlobally.True()
+	var teamIDs *[]int
+	if len(teams) > 0 {
+		ids := []int{}
+		if !shareWithCurrentTeam && !shareGlobally {
+			for _, id := range teams {
+				ids = append(ids, id.(int))
+			}
+		}
+		teamIDs = &ids
+	}
+
+	nc = v2.NotificationChannel{
+		Name:    d.Get("name").(string),
+		Enabled: d.Get("enabled").(bool),
+		TeamID:  tID,
+		TeamIDs: teamIDs,
+		Options: v2.NotificationChannelOptions{
+			NotifyOnOk:           d.Get("notify_when_ok").(bool),
+			NotifyOnResolve:      d.Get("notify_when_resolved").(bool),
+			SendTestNotification: d.Get("send_test_notification").(bool),
+		},
+	}
+	return
+}
+
+func notificationChannelToResourceData(nc *v2.NotificationChannel, data *schema.ResourceData) (err error) {
+	_ = data.Set("version", nc.Version)
+	_ = data.Set("name", nc.Name)
+	_ = data.Set("enabled", nc.Enabled)
+	var shareWithCurrentTeam bool
+	if nc.TeamID != nil {
+		shareWithCurrentTeam = true
+	}
+
+	err = data.Set("share_with_current_team", shareWithCurrentTeam)
+	if err != nil {
+		return err
+	}
+	teamIDs := notificationChannelTeamIDs(nc)
+	err = data.Set("shared_with_teams", teamIDs)
+	if err != nil {
+		return err
+	}
+	_ = data.Set("shared_globally", nc.TeamID == nil && len(teamIDs) == 0)
+	_ = data.Set("notify_when_ok", nc.Options.NotifyOnOk)
+	_ = data.Set("notify_when_resolved", nc.Options.NotifyOnResolve)
+	_ = data.Set("send_test_notification", nc.Options.SendTestNotification)
+
+	return
+}
+
+func notificationChannelEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_EMAIL
+	nc.Options.EmailRecipients = cast.ToStringSlice(d.Get("recipients").(*schema.Set).List())
+	return
+}
+
+func notificationChannelEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("recipients", nc.Options.EmailRecipients)
+
+	return
+}
+
+func notificationChannelSNSFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_AMAZON_SNS
+	nc.Options.SnsTopicARNs = cast.ToStringSlice(d.Get("topics").(*schema.Set).List())
+	return
+}
+
+func notificationChannelSNSToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("topics", nc.Options.SnsTopicARNs)
+
+	return
+}
+
+func notificationChannelOpsGenieFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_OPSGENIE
+	nc.Options.APIKey = notificationChannelSecretFromResourceData(d, "api_key")
+	nc.Options.Region = d.Get("region").(string)
+	return
+}
+
+func notificationChannelOpsGenieToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("api_key", nc.Options.APIKey)
+	_ = d.Set("region", nc.Options.Region)
+
+	return
+}
+
+func notificationChannelVictorOpsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_VICTOROPS
+	nc.Options.APIKey = notificationChannelSecretFromResourceData(d, "api_key")
+	nc.Options.RoutingKey = d.Get("routing_key").(string)
+	return
+}
+
+func notificationChannelVictorOpsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("api_key", nc.Options.APIKey)
+	_ = d.Set("routing_key", nc.Options.RoutingKey)
+
+	return
+}
+
+func notificationChannelWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_WEBHOOK
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.AdditionalHeaders = notificationChannelSecretMapFromResourceData(d, "additional_headers")
+	nc.Options.CustomData = d.Get("custom_data").(map[string]interface{})
+	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+	nc.Options.AllowInsecureConnections = &allowInsecureConnections
+	return
+}
+
+func notificationChannelWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
+	_ = d.Set("custom_data", nc.Options.CustomData)
+	if nc.Options.AllowInsecureConnections != nil {
+		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
+	}
+
+	return
+}
+
+func notificationChannelPagerdutyFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_PAGERDUTY
+	nc.Options.Account = d.Get("account").(string)
+	nc.Options.ServiceKey = notificationChannelSecretFromResourceData(d, "service_key")
+	nc.Options.ServiceName = d.Get("service_name").(string)
+
+	return
+}
+
+func notificationChannelPagerdutyToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("account", nc.Options.Account)
+	_ = d.Set("service_key", nc.Options.ServiceKey)
+	_ = d.Set("service_name", nc.Options.ServiceName)
+
+	return
+}
+
+func notificationChannelPrometheusAlertManagerFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.AdditionalHeaders = notificationChannelSecretMapFromResourceData(d, "additional_headers")
+	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+	nc.Options.AllowInsecureConnections = &allowInsecureConnections
+	return
+}
+
+func notificationChannelPrometheusAlertManagerToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
+
+	if nc.Options.AllowInsecureConnections != nil {
+		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
+	}
+
+	return
+}
+
+func notificationChannelTeamEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_TEAM_EMAIL
+	nc.Options.TeamId = d.Get("team_id").(int)
+	return
+}
+
+func notificationChannelTeamEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("team_id", nc.Options.TeamId)
+
+	return
+}
+
+func notificationChannelGoogleChatFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_GCHAT
+	nc.Options.Url = d.Get("url").(string)
+	return
+}
+
+func notificationChannelGoogleChatToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+
+	return
+}
+
+func notificationChannelCustomWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.HttpMethod = d.Get("http_method").(string)
+	nc.Options.MonitorTemplate = d.Get("template").(string)
+	nc.Options.AdditionalHeaders = notificationChannelSecretMapFromResourceData(d, "additional_headers")
+	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
+	nc.Options.AllowInsecureConnections = &allowInsecureConnections
+	return
+}
+
+func notificationChannelCustomWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
+	_ = d.Set("http_method", nc.Options.HttpMethod)
+	_ = d.Set("template", nc.Options.MonitorTemplate)
+	if nc.Options.AllowInsecureConnections != nil {
+		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
+	}
+
+	return
+}
+
+func notificationChannelIBMEventNotificationFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_IBM_EVENT_NOTIFICATION
+	nc.Options.InstanceId = d.Get("instance_id").(string)
+	return
+}
+
+func notificationChannelIBMEventNotificationToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("instance_id", nc.Options.InstanceId)
+
+	return
+}
+
+func notificationChannelIBMFunctionFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION
+	nc.Options.IbmFunctionType = d.Get("ibm_function_type").(string)
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.CustomData = d.Get("custom_data").(map[string]interface{})
+	if nc.Options.IbmFunctionType == "CLOUD_FUNCTION" {
+		nc.Options.APIKey = notificationChannelSecretFromResourceData(d, "iam_api_key")
+	} else {
+		nc.Options.APIKey = ""
+	}
+	if nc.Options.IbmFunctionType == "WEB_ACTION" {
+		nc.Options.AdditionalHeaders = map[string]interface{}{
+			"X-Require-Whisk-Auth": notificationChannelSecretFromResourceData(d, "whisk_auth_token"),
+		}
+	} else {
+		nc.Options.AdditionalHeaders = map[string]interface{}{}
+	}
+
+	return
+}
+
+func notificationChannelIBMFunctionToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("ibm_function_type", nc.Options.IbmFunctionType)
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("custom_data", nc.Options.CustomData)
+	_ = d.Set("iam_api_key", nc.Options.APIKey)
+	if nc.Options.AdditionalHeaders != nil {
+		whishAuthToken, ok := nc.Options.AdditionalHeaders["X-Require-Whisk-Auth"]
+		if ok {
+			_ = d.Set("whisk_auth_token", whishAuthToken)
+		}
+	}
+
+	return
+}
+
+func notificationChannelMSTeamsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_MS_TEAMS
+	nc.Options.Url = d.Get("url").(string)
+	if notificationChannelTemplateConfigurationConfigured(d) {
+		nc.Options.TemplateConfiguration = notificationChannelTemplateConfigurationFromResourceData(d)
+	}
+	return
+}
+
+func notificationChannelMSTeamsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	notificationChannelTemplateConfigurationToResourceData(nc, d)
+
+	return
+}
+
+func notificationChannelSlackFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_SLACK
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.Channel = d.Get("channel").(string)
+	if notificationChannelTemplateConfigurationConfigured(d) {
+		nc.Options.TemplateConfiguration = notificationChannelTemplateConfigurationFromResourceData(d)
+	}
+	return
+}
+
+func notificationChannelSlackToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("channel", nc.Options.Channel)
+	notificationChannelTemplateConfigurationToResourceData(nc, d)
+
+	return
+}
+
+func notificationChannelServiceNowFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_SERVICENOW
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.Username = d.Get("username").(string)
+	nc.Options.Password = notificationChannelSecretFromResourceData(d, "password")
+	return
+}
+
+func notificationChannelServiceNowToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("username", nc.Options.Username)
+
+	return
+}
+
+func notificationChannelJiraFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_JIRA
+	nc.Options.Url = d.Get("url").(string)
+	nc.Options.Username = d.Get("username").(string)
+	nc.Options.APIToken = notificationChannelSecretFromResourceData(d, "api_token")
+	nc.Options.Project = d.Get("issue_mapping.0.project").(string)
+	nc.Options.IssueType = d.Get("issue_mapping.0.issue_type").(string)
+	return
+}
+
+func notificationChannelJiraToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("url", nc.Options.Url)
+	_ = d.Set("username", nc.Options.Username)
+	_ = d.Set("issue_mapping", []map[string]interface{}{{
+		"project":    nc.Options.Project,
+		"issue_type": nc.Options.IssueType,
+	}})
+
+	return
+}
+
+func notificationChannelWebexFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_WEBEX
+	nc.Options.RoomId = d.Get("room_id").(string)
+	nc.Options.BotToken = notificationChannelSecretFromResourceData(d, "bot_token")
+	return
+}
+
+func notificationChannelWebexToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	err = notificationChannelToResourceData(nc, d)
+	if err != nil {
+		return
+	}
+
+	_ = d.Set("room_id", nc.Options.RoomId)
+
+	return
+}
+
+func notificationChannelZendutyFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
+	nc, err = notificationChannelFromResourceData(d, teamID)
+	if err != nil {
+		return
+	}
+
+	nc.Type = NOTIFICATION_CHANNEL_TYPE_ZENDUTY
+	nc.Options.RoutingKey = notificationChannelSecretFromResourceData(d, "routing_key")
+	return
+}
+
+func notificationChannelZendutyToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
+	return notificationChannelToResourceData(nc, d)
+}
@@ -0,0 +1,156 @@
+package sysdig
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const notificationChannelSecretHashPrefix = "sha256:"
+
+// notificationChannelSecrets are the attributes of each type of channel that the API masks or omits when it is read.
+// They are write-only: they are sent from the configuration, and only their hash is kept in the state. Of the maps of
+// headers, only the authentication headers are secrets.
+var notificationChannelSecrets = map[string][]string{
+	NOTIFICATION_CHANNEL_TYPE_OPSGENIE:                 {"api_key"},
+	NOTIFICATION_CHANNEL_TYPE_VICTOROPS:                {"api_key"},
+	NOTIFICATION_CHANNEL_TYPE_PAGERDUTY:                {"service_key"},
+	NOTIFICATION_CHANNEL_TYPE_WEBHOOK:                  {"additional_headers"},
+	NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER: {"additional_headers"},
+	NOTIFICATION_CHANNEL_TYPE_CUSTOM_WEBHOOK:           {"additional_headers"},
+	NOTIFICATION_CHANNEL_TYPE_IBM_FUNCTION:             {"iam_api_key", "whisk_auth_token"},
+	NOTIFICATION_CHANNEL_TYPE_SERVICENOW:               {"password"},
+	NOTIFICATION_CHANNEL_TYPE_JIRA:                     {"api_token"},
+	NOTIFICATION_CHANNEL_TYPE_WEBEX:                    {"bot_token"},
+	NOTIFICATION_CHANNEL_TYPE_ZENDUTY:                  {"routing_key"},
+}
+
+// createNotificationChannelSchemaWithSecrets is the schema of the resources of the channels having secrets,
+// secret_version can be changed to send the secrets again, e.g. when they have been changed outside of Terraform
+func createNotificationChannelSchemaWithSecrets(channelType string, original map[string]*schema.Schema) map[string]*schema.Schema {
+	notificationChannelSchema := createNotificationChannelSchema(original)
+	for _, secret := range notificationChannelSecrets[channelType] {
+		notificationChannelSchema[secret].Sensitive = true
+		notificationChannelSchema[secret].DiffSuppressFunc = suppressNotificationChannelSecretDiff
+	}
+	notificationChannelSchema["secret_version"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return notificationChannelSchema
+}
+
+// notificationChannelSecretHeaders are the headers, in lower case, whose values are secrets
+var notificationChannelSecretHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"x-api-key":           true,
+	"api-key":             true,
+	"x-auth-token":        true,
+}
+
+func isNotificationChannelSecretHeader(header string) bool {
+	return notificationChannelSecretHeaders[strings.ToLower(header)]
+}
+
+func hashNotificationChannelSecret(secret string) string {
+	if secret == "" || strings.HasPrefix(secret, notificationChannelSecretHashPrefix) {
+		return secret
+	}
+	return fmt.Sprintf("%s%x", notificationChannelSecretHashPrefix, sha256.Sum256([]byte(secret)))
+}
+
+// suppressNotificationChannelSecretDiff compares the secret in the configuration with the hash in the state,
+// the values of the maps of headers being compared one by one
+func suppressNotificationChannelSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+	if _, header, found := strings.Cut(k, "."); found && !isNotificationChannelSecretHeader(header) {
+		return old == new
+	}
+	return old == hashNotificationChannelSecret(new)
+}
+
+// notificationChannelSecretFromResourceData returns a secret from the configuration, as the state only has its hash
+func notificationChannelSecretFromResourceData(d *schema.ResourceData, name string) string {
+	value := rawConfigAttr(d.GetRawConfig(), name)
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
+
+// notificationChannelSecretMapFromResourceData returns a map of secrets from the configuration, as the state only has their hashes
+func notificationChannelSecretMapFromResourceData(d *schema.ResourceData, name string) map[string]interface{} {
+	secrets := map[string]interface{}{}
+	value := rawConfigAttr(d.GetRawConfig(), name)
+	if value.IsNull() || !value.IsKnown() {
+		return secrets
+	}
+	for key, secret := range value.AsValueMap() {
+		if !secret.IsNull() && secret.IsKnown() {
+			secrets[key] = secret.AsString()
+		}
+	}
+	return secrets
+}
+
+// writeOnlyNotificationChannelToResourceData sets the channel in the resource data with toResourceData, except its
+// secrets: the hashes of the configured ones are set instead, or the ones in the state are kept when there is no
+// configuration, as when the resource is refreshed or imported. The headers which are not secrets are kept as read.
+func writeOnlyNotificationChannelToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData, toResourceData func(*v2.NotificationChannel, *schema.ResourceData) error) error {
+	secrets := notificationChannelSecrets[nc.Type]
+
+	state := map[string]interface{}{}
+	for _, secret := range secrets {
+		state[secret] = d.Get(secret)
+	}
+
+	err := toResourceData(nc, d)
+	if err != nil {
+		return err
+	}
+
+	config := d.GetRawConfig()
+	configured := !config.IsNull() && config.IsKnown()
+	for _, secret := range secrets {
+		switch value := state[secret].(type) {
+		case string:
+			if configured {
+				value = notificationChannelSecretFromResourceData(d, secret)
+			}
+			_ = d.Set(secret, hashNotificationChannelSecret(value))
+		case map[string]interface{}:
+			if configured {
+				value = notificationChannelSecretMapFromResourceData(d, secret)
+			}
+			headers := map[string]interface{}{}
+			for key, v := range d.Get(secret).(map[string]interface{}) {
+				if !isNotificationChannelSecretHeader(key) {
+					headers[key] = v
+				}
+			}
+			for key, v := range value {
+				if isNotificationChannelSecretHeader(key) {
+					headers[key] = hashNotificationChannelSecret(v.(string))
+				}
+			}
+			_ = d.Set(secret, headers)
+		}
+	}
+
+	return nil
+}
+
+func rawConfigAttr(config cty.Value, name string) cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return config.GetAttr(name)
+}
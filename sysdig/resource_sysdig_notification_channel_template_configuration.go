@@ -0,0 +1,234 @@
+package sysdig
+
+import (
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationChannelTemplateSections are the sections of the known templates, the API shows the ones it omits
+var notificationChannelTemplateSections = map[string][]string{
+	NOTIFICATION_CHANNEL_TYPE_SLACK_MONITOR_TEMPLATE_KEY_V1:    notificationChannelMonitorAlertTemplateSections,
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_MONITOR_TEMPLATE_KEY_V1: notificationChannelMonitorAlertTemplateSections,
+	NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V1:            {NOTIFICATION_CHANNEL_SECURE_EVENT_NOTIFICATION_CONTENT_SECTION},
+	NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V2:            {NOTIFICATION_CHANNEL_SECURE_EVENT_NOTIFICATION_CONTENT_SECTION},
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V1:         {NOTIFICATION_CHANNEL_SECURE_EVENT_NOTIFICATION_CONTENT_SECTION},
+	NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V2:         {NOTIFICATION_CHANNEL_SECURE_EVENT_NOTIFICATION_CONTENT_SECTION},
+}
+
+var notificationChannelMonitorAlertTemplateSections = []string{
+	"MONITOR_ALERT_NOTIFICATION_HEADER",
+	"MONITOR_ALERT_NOTIFICATION_RUNBOOK_LINKS",
+	"MONITOR_ALERT_NOTIFICATION_EVENT_DETAILS",
+	"MONITOR_ALERT_NOTIFICATION_USER_DEFINED_CONTENT",
+	"MONITOR_ALERT_NOTIFICATION_CHART",
+	"MONITOR_ALERT_NOTIFICATION_DASHBOARD_LINKS",
+	"MONITOR_ALERT_NOTIFICATION_ALERT_DETAILS",
+	"MONITOR_ALERT_NOTIFICATION_CAPTURING_INFORMATION",
+}
+
+// notificationChannelTemplateConfigurationSchema is the template configuration of the Slack and MS Teams channels,
+// the sections which are not configured are shown
+func notificationChannelTemplateConfigurationSchema(conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: conflictsWith,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"template_key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"section": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"show": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func notificationChannelTemplateConfigurationDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"template_key": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"section": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"show": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// suppressDiffWithTemplateConfiguration ignores the attributes the template configuration is built from when it is
+// configured as a whole with template_configuration
+func suppressDiffWithTemplateConfiguration(k, old, new string, d *schema.ResourceData) bool {
+	return notificationChannelTemplateConfigurationConfigured(d)
+}
+
+func notificationChannelTemplateConfigurationConfigured(d *schema.ResourceData) bool {
+	return len(rawConfigBlocks(d.GetRawConfig(), "template_configuration")) > 0
+}
+
+func notificationChannelTemplateConfigurationFromResourceData(d *schema.ResourceData) []v2.NotificationChannelTemplateConfiguration {
+	var templateConfiguration []v2.NotificationChannelTemplateConfiguration
+	for _, c := range d.Get("template_configuration").([]interface{}) {
+		config := c.(map[string]interface{})
+		templateKey := config["template_key"].(string)
+
+		shown := map[string]bool{}
+		var names []string
+		for _, s := range config["section"].(*schema.Set).List() {
+			section := s.(map[string]interface{})
+			name := section["name"].(string)
+			shown[name] = section["show"].(bool)
+			names = append(names, name)
+		}
+		for _, name := range notificationChannelTemplateSections[templateKey] {
+			if _, ok := shown[name]; !ok {
+				shown[name] = true
+				names = append(names, name)
+			}
+		}
+
+		var sections []v2.NotificationChannelTemplateConfigurationSection
+		for _, name := range names {
+			sections = append(sections, v2.NotificationChannelTemplateConfigurationSection{
+				SectionName: name,
+				ShouldShow:  shown[name],
+			})
+		}
+		templateConfiguration = append(templateConfiguration, v2.NotificationChannelTemplateConfiguration{
+			TemplateKey:                   templateKey,
+			TemplateConfigurationSections: sections,
+		})
+	}
+	return templateConfiguration
+}
+
+// notificationChannelTemplateConfigurationToResourceData sets the template configuration, in the order of the one in
+// the state. The sections the API omits are shown, and the shown sections are only kept when they are in the state,
+// so that there is no difference with a configuration only listing some of the sections.
+func notificationChannelTemplateConfigurationToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) {
+	inState := map[string]map[string]bool{}
+	var order []string
+	for _, c := range d.Get("template_configuration").([]interface{}) {
+		config, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		templateKey := config["template_key"].(string)
+		order = append(order, templateKey)
+		inState[templateKey] = map[string]bool{}
+		if sections, ok := config["section"].(*schema.Set); ok {
+			for _, s := range sections.List() {
+				inState[templateKey][s.(map[string]interface{})["name"].(string)] = true
+			}
+		}
+	}
+
+	flattened := flattenNotificationChannelTemplateConfiguration(nc.Options.TemplateConfiguration, func(templateKey, section string, show bool) bool {
+		return !show || inState[templateKey][section]
+	})
+
+	var templateConfiguration []interface{}
+	for _, templateKey := range order {
+		for i, config := range flattened {
+			if config != nil && config.(map[string]interface{})["template_key"] == templateKey {
+				templateConfiguration = append(templateConfiguration, config)
+				flattened[i] = nil
+			}
+		}
+	}
+	for _, config := range flattened {
+		if config != nil {
+			templateConfiguration = append(templateConfiguration, config)
+		}
+	}
+
+	_ = d.Set("template_configuration", templateConfiguration)
+}
+
+// flattenNotificationChannelTemplateConfiguration returns the template configuration with the sections the API omits
+// shown, keeping the sections for which keep returns true, or all of them when keep is nil
+func flattenNotificationChannelTemplateConfiguration(templateConfiguration []v2.NotificationChannelTemplateConfiguration, keep func(templateKey, section string, show bool) bool) []interface{} {
+	var flattened []interface{}
+	for _, config := range templateConfiguration {
+		shown := map[string]bool{}
+		for _, name := range notificationChannelTemplateSections[config.TemplateKey] {
+			shown[name] = true
+		}
+		for _, section := range config.TemplateConfigurationSections {
+			shown[section.SectionName] = section.ShouldShow
+		}
+
+		var sections []interface{}
+		for name, show := range shown {
+			if keep == nil || keep(config.TemplateKey, name, show) {
+				sections = append(sections, map[string]interface{}{
+					"name": name,
+					"show": show,
+				})
+			}
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"template_key": config.TemplateKey,
+			"section":      sections,
+		})
+	}
+	return flattened
+}
+
+// notificationChannelTemplateSectionsOf returns the sections of the first template configuration with one of the keys,
+// with the sections the API omits shown
+func notificationChannelTemplateSectionsOf(nc *v2.NotificationChannel, templateKeys ...string) (string, map[string]bool) {
+	for _, config := range nc.Options.TemplateConfiguration {
+		for _, templateKey := range templateKeys {
+			if config.TemplateKey != templateKey {
+				continue
+			}
+			shown := map[string]bool{}
+			for _, name := range notificationChannelTemplateSections[templateKey] {
+				shown[name] = true
+			}
+			for _, section := range config.TemplateConfigurationSections {
+				shown[section.SectionName] = section.ShouldShow
+			}
+			return templateKey, shown
+		}
+	}
+	return "", nil
+}
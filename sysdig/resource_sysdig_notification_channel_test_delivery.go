@@ -0,0 +1,134 @@
+package sysdig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceSysdigNotificationChannelTest sends a test notification with a channel when it is created, it is created
+// again to send a new one when its triggers change
+func resourceSysdigNotificationChannelTest() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigNotificationChannelTestCreate,
+		ReadContext:   resourceSysdigNotificationChannelTestRead,
+		DeleteContext: resourceSysdigNotificationChannelTestDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"monitor", "secure"}, false),
+			},
+			"notification_channel_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fail_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"success": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tested_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getNotificationChannelClientByProduct(c SysdigClients, product string) (v2.NotificationChannelInterface, error) {
+	if product == "secure" {
+		return getSecureNotificationChannelClient(c)
+	}
+	return getMonitorNotificationChannelClient(c)
+}
+
+func resourceSysdigNotificationChannelTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getNotificationChannelClientByProduct(meta.(SysdigClients), d.Get("product").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("notification_channel_id").(int)
+	result, err := client.TestNotificationChannel(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// a failed delivery is not stored when it fails the apply, so that the test is sent again by the next one
+	if !result.Success && d.Get("fail_on_error").(bool) {
+		return diag.Errorf("the test notification of the notification channel %d failed: %s", id, result.Message)
+	}
+
+	d.SetId(strconv.Itoa(id))
+	_ = d.Set("success", result.Success)
+	_ = d.Set("message", result.Message)
+	_ = d.Set("tested_at", time.Now().UTC().Format(time.RFC3339))
+
+	if !result.Success {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "test notification failed",
+			Detail:   fmt.Sprintf("the test notification of the notification channel %d failed: %s", id, result.Message),
+		}}
+	}
+
+	return nil
+}
+
+// resourceSysdigNotificationChannelTestRead only checks that the channel still exists, the test being sent again when
+// it has been recreated
+func resourceSysdigNotificationChannelTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getNotificationChannelClientByProduct(meta.(SysdigClients), d.Get("product").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.GetNotificationChannelById(ctx, d.Get("notification_channel_id").(int))
+	if err != nil {
+		if err == v2.NotificationChannelNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigNotificationChannelTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
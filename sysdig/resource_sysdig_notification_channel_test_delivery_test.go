@@ -0,0 +1,61 @@
+//go:build tf_acc_sysdig_monitor || tf_acc_sysdig_common || tf_acc_ibm_monitor || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccNotificationChannelTest(t *testing.T) {
+	rText := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: sysdigOrIBMMonitorPreCheck(t),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: notificationChannelTestWithTrigger(rText, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sysdig_notification_channel_test.sample", "notification_channel_id", "sysdig_monitor_notification_channel_webhook.sample", "id"),
+					resource.TestCheckResourceAttrSet("sysdig_notification_channel_test.sample", "success"),
+					resource.TestCheckResourceAttrSet("sysdig_notification_channel_test.sample", "tested_at"),
+				),
+			},
+			{
+				Config: notificationChannelTestWithTrigger(rText, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_notification_channel_test.sample", "triggers.url_version", "2"),
+					resource.TestCheckResourceAttrSet("sysdig_notification_channel_test.sample", "tested_at"),
+				),
+			},
+		},
+	})
+}
+
+func notificationChannelTestWithTrigger(name, trigger string) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_notification_channel_webhook" "sample" {
+	name = "Example Channel %s - Webhook"
+	url = "https://example.com/"
+}
+
+resource "sysdig_notification_channel_test" "sample" {
+	product = "monitor"
+	notification_channel_id = sysdig_monitor_notification_channel_webhook.sample.id
+	fail_on_error = false
+	triggers = {
+		url_version = "%s"
+	}
+}`, name, trigger)
+}
@@ -0,0 +1,152 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelCustomWebhook(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelCustomWebhookWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_custom_webhook.sample-custom-webhook1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
+			},
+			{
+				Config: secureNotificationChannelCustomWebhookWithNameWithAdditionalheaders(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_custom_webhook.sample-custom-webhook2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
+			},
+			{
+				Config: secureNotificationChannelCustomWebhookSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_custom_webhook.sample-custom-webhook3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
+			},
+			{
+				Config: secureNotificationChannelCustomWebhookSharedWithAllowInsecureConnections(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_custom_webhook.sample-custom-webhook4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
+			},
+			{
+				Config: secureNotificationChannelCustomWebhookSharedWithAdditionalHeaders(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_custom_webhook.sample-custom-webhook5",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
+			},
+		},
+	})
+}
+
+func secureNotificationChannelCustomWebhookWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_custom_webhook" "sample-custom-webhook1" {
+	name = "Example Channel %s - Custom Webhook"
+	enabled = true
+	url = "https://example.com/"
+	http_method = "POST"
+	template = "{\n  \"code\": \"incident\",\n  \"alert\": \"{{@alert_name}}\"\n}"
+	notify_when_ok = false
+	notify_when_resolved = false
+	send_test_notification = false
+}`, name)
+}
+
+func secureNotificationChannelCustomWebhookWithNameWithAdditionalheaders(name string) string {
+	return fmt.Sprintf(`
+	resource "sysdig_secure_notification_channel_custom_webhook" "sample-custom-webhook2" {
+		name = "Example Channel %s - Custom Webhook With Additional Headers"
+		enabled = true
+		url = "https://example.com/"
+		http_method = "POST"
+		template = "{\n  \"code\": \"incident\",\n  \"alert\": \"{{@alert_name}}\"\n}"
+		notify_when_ok = false
+		notify_when_resolved = false
+		send_test_notification = false
+		additional_headers = {
+			"Webhook-Header": "TestHeader"
+		}
+	}`, name)
+}
+
+func secureNotificationChannelCustomWebhookSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+	resource "sysdig_secure_notification_channel_custom_webhook" "sample-custom-webhook3" {
+		name = "Example Channel %s - Custom Webhook With Additional Headers"
+		share_with_current_team = true
+		enabled = true
+		url = "https://example.com/"
+		http_method = "POST"
+		template = "{\n  \"code\": \"incident\",\n  \"alert\": \"{{@alert_name}}\"\n}"
+		notify_when_ok = false
+		notify_when_resolved = false
+		send_test_notification = false
+	}`, name)
+}
+
+func secureNotificationChannelCustomWebhookSharedWithAllowInsecureConnections(name string) string {
+	return fmt.Sprintf(`
+	resource "sysdig_secure_notification_channel_custom_webhook" "sample-custom-webhook4" {
+		name = "Example Channel %s - Custom Webhook With Additional Headers"
+		enabled = true
+		url = "https://example.com/"
+		http_method = "POST"
+		template = "{\n  \"code\": \"incident\",\n  \"alert\": \"{{@alert_name}}\"\n}"
+		allow_insecure_connections = true
+		notify_when_ok = false
+		notify_when_resolved = false
+		send_test_notification = false
+	}`, name)
+}
+
+func secureNotificationChannelCustomWebhookSharedWithAdditionalHeaders(name string) string {
+	return fmt.Sprintf(`
+	resource "sysdig_secure_notification_channel_custom_webhook" "sample-custom-webhook5" {
+		name = "Example Channel %s - Custom Webhook With Additional Headers"
+		enabled = true
+		url = "https://example.com/"
+		http_method = "POST"
+		template = "{\n  \"code\": \"incident\",\n  \"alert\": \"{{@alert_name}}\"\n}"
+		additional_headers = {
+			"Webhook-Header": "TestHeader"
+		}
+		notify_when_ok = false
+		notify_when_resolved = false
+		send_test_notification = false
+	}`, name)
+}
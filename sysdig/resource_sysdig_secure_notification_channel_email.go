@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/spf13/cast"
 )
 
 func resourceSysdigSecureNotificationChannelEmail() *schema.Resource {
@@ -32,7 +31,7 @@ func resourceSysdigSecureNotificationChannelEmail() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"recipients": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -53,7 +52,7 @@ func resourceSysdigSecureNotificationChannelEmailCreate(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelEmailFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -84,7 +83,7 @@ func resourceSysdigSecureNotificationChannelEmailRead(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelEmailToResourceData(&nc, d)
+	err = notificationChannelEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -103,7 +102,7 @@ func resourceSysdigSecureNotificationChannelEmailUpdate(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelEmailFromResourceData(d, teamID)
+	nc, err := notificationChannelEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -134,25 +133,3 @@ func resourceSysdigSecureNotificationChannelEmailDelete(ctx context.Context, d *
 
 	return nil
 }
-
-func secureNotificationChannelEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_EMAIL
-	nc.Options.EmailRecipients = cast.ToStringSlice(d.Get("recipients").(*schema.Set).List())
-	return
-}
-
-func secureNotificationChannelEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("recipients", nc.Options.EmailRecipients)
-
-	return
-}
@@ -0,0 +1,68 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelGoogleChat(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelGoogleChatWithName(rText()),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_google_chat.sample_google_chat1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: secureNotificationChannelGoogleChatSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_google_chat.sample_google_chat2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func secureNotificationChannelGoogleChatWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_google_chat" "sample_google_chat1" {
+	name = "Example Channel %s - google chat"
+	enabled = true
+	url = "https://chat.googleapis.com/v1/spaces/XXXXXX/messages?key=XXXXXXXXXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func secureNotificationChannelGoogleChatSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_google_chat" "sample_google_chat2" {
+	name = "Example Channel %s - google chat"
+	enabled = true
+	url = "https://chat.googleapis.com/v1/spaces/XXXXXX/messages?key=XXXXXXXXXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
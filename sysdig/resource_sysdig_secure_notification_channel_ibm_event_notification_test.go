@@ -0,0 +1,75 @@
+//go:build tf_acc_ibm_secure || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelIBMEventNotification(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	ibmEventNotificationInstanceId := os.Getenv("IBM_EVENT_NOTIFICATION_INSTANCE_ID")
+	if ibmEventNotificationInstanceId == "" {
+		t.Skip("Skipping tests on sysdig_secure_notification_channel_ibm_event_notification resource because IBM_EVENT_NOTIFICATION_INSTANCE_ID is not set")
+		return
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelIBMEventNotificationWithName(rText(), ibmEventNotificationInstanceId),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_ibm_event_notification.sample1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: secureNotificationChannelIBMEventNotificationSharedWithCurrentTeam(rText(), ibmEventNotificationInstanceId),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_ibm_event_notification.sample2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func secureNotificationChannelIBMEventNotificationWithName(name, ibmEventNotificationInstanceId string) string {
+	return fmt.Sprintf(`
+	resource "sysdig_secure_notification_channel_ibm_event_notification" "sample1" {
+		name = "Example Channel %s - IBM Event Notification"
+		enabled = true
+		instance_id = "%s"
+		notify_when_ok = true
+		notify_when_resolved = true
+}`, name, ibmEventNotificationInstanceId)
+}
+
+func secureNotificationChannelIBMEventNotificationSharedWithCurrentTeam(name, ibmEventNotificationInstanceId string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_ibm_event_notification" "sample2" {
+	name = "Example Channel %s - IBM Event Notification"
+	share_with_current_team = true
+	enabled = true
+	instance_id = "%s"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name, ibmEventNotificationInstanceId)
+}
@@ -0,0 +1,82 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelJira(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelJiraWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_jira.sample_jira1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+			{
+				Config: secureNotificationChannelJiraSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_jira.sample_jira2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func secureNotificationChannelJiraWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_jira" "sample_jira1" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	username = "sysdig@example.com"
+	api_token = "XXXXXXXXXX"
+	issue_mapping {
+		project = "OPS"
+		issue_type = "Task"
+	}
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func secureNotificationChannelJiraSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_jira" "sample_jira2" {
+	name = "Example Channel %s - Jira"
+	enabled = true
+	url = "https://example.atlassian.net"
+	username = "sysdig@example.com"
+	api_token = "XXXXXXXXXX"
+	issue_mapping {
+		project = "OPS"
+		issue_type = "Task"
+	}
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
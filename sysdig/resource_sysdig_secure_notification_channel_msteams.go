@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -31,15 +30,18 @@ func resourceSysdigSecureNotificationChannelMSTeams() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"template_version": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationSchema("template_version"),
 		}),
 	}
 }
@@ -148,15 +150,15 @@ func resourceSysdigSecureNotificationChannelMSTeamsDelete(ctx context.Context, d
 }
 
 func secureNotificationChannelMSTeamsFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
+	nc, err = notificationChannelMSTeamsFromResourceData(d, teamID)
 	if err != nil {
 		return
 	}
 
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_MS_TEAMS
-	nc.Options.Url = d.Get("url").(string)
-
-	setNotificationChannelMSTeamsTemplateConfig(&nc, d)
+	// template_version is a shorthand for the secure event template, template_configuration being sent instead when configured
+	if !notificationChannelTemplateConfigurationConfigured(d) {
+		setNotificationChannelMSTeamsTemplateConfig(&nc, d)
+	}
 
 	return
 }
@@ -193,28 +195,24 @@ func setNotificationChannelMSTeamsTemplateConfig(nc *v2.NotificationChannel, d *
 }
 
 func secureNotificationChannelMSTeamsToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
+	err = notificationChannelMSTeamsToResourceData(nc, d)
 	if err != nil {
 		return
 	}
 
-	_ = d.Set("url", nc.Options.Url)
-
 	err = getTemplateVersionFromNotificationChannelMSTeams(nc, d)
 
 	return
 }
 
 func getTemplateVersionFromNotificationChannelMSTeams(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	if len(nc.Options.TemplateConfiguration) == 0 {
+	// the channel can have other templates than the secure event one, which template_version is the version of
+	templateKey, _ := notificationChannelTemplateSectionsOf(nc, NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V1, NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V2)
+	if templateKey == "" {
 		return
 	}
 
-	if len(nc.Options.TemplateConfiguration) > 1 {
-		return fmt.Errorf("expected ms teams notification templates to have only one configuration, found %d", len(nc.Options.TemplateConfiguration))
-	}
-
-	switch nc.Options.TemplateConfiguration[0].TemplateKey {
+	switch templateKey {
 	case NOTIFICATION_CHANNEL_TYPE_MS_TEAMS_TEMPLATE_KEY_V2:
 		_ = d.Set("template_version", "v2")
 	default:
@@ -48,6 +48,19 @@ func TestAccSecureNotificationChannelMSTeams(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: secureNotificationChannelMSTeamsWithTemplateConfiguration(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_msteams.sample-msteams", "template_configuration.0.template_key", "MS_TEAMS_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_msteams.sample-msteams", "template_configuration.0.section.0.show", "false"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_msteams.sample-msteams", "template_version", "v2"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_msteams.sample-msteams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -86,3 +99,21 @@ resource "sysdig_secure_notification_channel_msteams" "sample-msteams" {
 	template_version = "%s"
 }`, name, version)
 }
+
+func secureNotificationChannelMSTeamsWithTemplateConfiguration(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_msteams" "sample-msteams" {
+	name = "Example Channel %s - MS Teams"
+	enabled = true
+	url = "https://hooks.msteams.cwom/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	template_configuration {
+		template_key = "MS_TEAMS_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"
+		section {
+			name = "SECURE_EVENT_NOTIFICATION_CONTENT"
+			show = false
+		}
+	}
+}`, name)
+}
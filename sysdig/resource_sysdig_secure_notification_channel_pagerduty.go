@@ -31,7 +31,7 @@ func resourceSysdigSecureNotificationChannelPagerduty() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_PAGERDUTY, map[string]*schema.Schema{
 			"account": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -59,7 +59,7 @@ func resourceSysdigSecureNotificationChannelPagerdutyCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelPagerdutyFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelPagerdutyFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,7 +90,7 @@ func resourceSysdigSecureNotificationChannelPagerdutyRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelPagerdutyToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelPagerdutyToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -109,7 +109,7 @@ func resourceSysdigSecureNotificationChannelPagerdutyUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelPagerdutyFromResourceData(d, teamID)
+	nc, err := notificationChannelPagerdutyFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,7 +122,7 @@ func resourceSysdigSecureNotificationChannelPagerdutyUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigSecureNotificationChannelPagerdutyRead(ctx, d, meta)
 }
 
 func resourceSysdigSecureNotificationChannelPagerdutyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -140,30 +140,3 @@ func resourceSysdigSecureNotificationChannelPagerdutyDelete(ctx context.Context,
 
 	return nil
 }
-
-func secureNotificationChannelPagerdutyFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_PAGERDUTY
-	nc.Options.Account = d.Get("account").(string)
-	nc.Options.ServiceKey = d.Get("service_key").(string)
-	nc.Options.ServiceName = d.Get("service_name").(string)
-
-	return
-}
-
-func secureNotificationChannelPagerdutyToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("account", nc.Options.Account)
-	_ = d.Set("service_key", nc.Options.ServiceKey)
-	_ = d.Set("service_name", nc.Options.ServiceName)
-
-	return
-}
@@ -31,7 +31,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManager() *schema.Res
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER, map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -61,7 +61,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerCreate(ctx con
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -92,7 +92,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerRead(ctx conte
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelPrometheusAlertManagerToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelPrometheusAlertManagerToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -111,7 +111,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerUpdate(ctx con
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
+	nc, err := notificationChannelPrometheusAlertManagerFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -124,7 +124,7 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerUpdate(ctx con
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigSecureNotificationChannelPrometheusAlertManagerRead(ctx, d, meta)
 }
 
 func resourceSysdigSecureNotificationChannelPrometheusAlertManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -142,33 +142,3 @@ func resourceSysdigSecureNotificationChannelPrometheusAlertManagerDelete(ctx con
 
 	return nil
 }
-
-func secureNotificationChannelPrometheusAlertManagerFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_PROMETHEUS_ALERT_MANAGER
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]interface{})
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
-	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return
-}
-
-func secureNotificationChannelPrometheusAlertManagerToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return
-}
@@ -28,33 +28,37 @@ func TestAccSecureNotificationChannelPrometheusAlertManager(t *testing.T) {
 				Config: secureNotificationChannelPrometheusAlertManagerWithName(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel1",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelPrometheusAlertManagerWithNameWithAdditionalheaders(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel2",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelPrometheusAlertManagerWithNameWithAllowInsecureConnections(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelPrometheusAlertManagerSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_prometheus_alert_manager.sample-channel4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 		},
 	})
@@ -0,0 +1,74 @@
+//go:build tf_acc_sysdig_secure || tf_acc_sysdig_common || tf_acc_ibm_secure || tf_acc_ibm_common
+
+package sysdig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccSecureNotificationChannelServiceNow(t *testing.T) {
+	rText := func() string { return acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) }
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: preCheckAnyEnv(t, SysdigSecureApiTokenEnv, SysdigIBMSecureAPIKeyEnv),
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: secureNotificationChannelServiceNowWithName(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_servicenow.sample_servicenow1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: secureNotificationChannelServiceNowSharedWithCurrentTeam(rText()),
+			},
+			{
+				ResourceName:            "sysdig_secure_notification_channel_servicenow.sample_servicenow2",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func secureNotificationChannelServiceNowWithName(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_servicenow" "sample_servicenow1" {
+	name = "Example Channel %s - ServiceNow"
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+}`, name)
+}
+
+func secureNotificationChannelServiceNowSharedWithCurrentTeam(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_servicenow" "sample_servicenow2" {
+	name = "Example Channel %s - ServiceNow"
+	enabled = true
+	url = "https://example.service-now.com"
+	username = "sysdig"
+	password = "XXXXXXXXXX"
+	notify_when_ok = true
+	notify_when_resolved = true
+	share_with_current_team = true
+}`, name)
+}
@@ -2,7 +2,6 @@ package sysdig
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
@@ -31,7 +30,7 @@ func resourceSysdigSecureNotificationChannelSlack() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -41,9 +40,12 @@ func resourceSysdigSecureNotificationChannelSlack() *schema.Resource {
 				Required: true,
 			},
 			"template_version": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"template_configuration"},
+				DiffSuppressFunc: suppressDiffWithTemplateConfiguration,
 			},
+			"template_configuration": notificationChannelTemplateConfigurationSchema("template_version"),
 		}),
 	}
 }
@@ -154,16 +156,15 @@ func resourceSysdigSecureNotificationChannelSlackDelete(ctx context.Context, d *
 }
 
 func secureNotificationChannelSlackFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
+	nc, err = notificationChannelSlackFromResourceData(d, teamID)
 	if err != nil {
 		return
 	}
 
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_SLACK
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.Channel = d.Get("channel").(string)
-
-	setNotificationChannelSlackTemplateConfig(&nc, d)
+	// template_version is a shorthand for the secure event template, template_configuration being sent instead when configured
+	if !notificationChannelTemplateConfigurationConfigured(d) {
+		setNotificationChannelSlackTemplateConfig(&nc, d)
+	}
 
 	return
 }
@@ -200,29 +201,24 @@ func setNotificationChannelSlackTemplateConfig(nc *v2.NotificationChannel, d *sc
 }
 
 func secureNotificationChannelSlackToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
+	err = notificationChannelSlackToResourceData(nc, d)
 	if err != nil {
 		return
 	}
 
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("channel", nc.Options.Channel)
-
 	err = getTemplateVersionFromNotificationChannelSlack(nc, d)
 
 	return
 }
 
 func getTemplateVersionFromNotificationChannelSlack(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	if len(nc.Options.TemplateConfiguration) == 0 {
+	// the channel can have other templates than the secure event one, which template_version is the version of
+	templateKey, _ := notificationChannelTemplateSectionsOf(nc, NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V1, NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V2)
+	if templateKey == "" {
 		return
 	}
 
-	if len(nc.Options.TemplateConfiguration) > 1 {
-		return fmt.Errorf("expected slack notification templates to have only one configuration, found %d", len(nc.Options.TemplateConfiguration))
-	}
-
-	switch nc.Options.TemplateConfiguration[0].TemplateKey {
+	switch templateKey {
 	case NOTIFICATION_CHANNEL_TYPE_SLACK_TEMPLATE_KEY_V2:
 		_ = d.Set("template_version", "v2")
 	default:
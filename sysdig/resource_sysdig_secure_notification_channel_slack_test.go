@@ -48,6 +48,19 @@ func TestAccSecureNotificationChannelSlack(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: secureNotificationChannelSlackWithTemplateConfiguration(rText()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_slack.sample-slack", "template_configuration.0.template_key", "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_slack.sample-slack", "template_configuration.0.section.0.show", "false"),
+					resource.TestCheckResourceAttr("sysdig_secure_notification_channel_slack.sample-slack", "template_version", "v2"),
+				),
+			},
+			{
+				ResourceName:      "sysdig_secure_notification_channel_slack.sample-slack",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -89,3 +102,22 @@ resource "sysdig_secure_notification_channel_slack" "sample-slack" {
 	template_version = "%s"
 }`, name, version)
 }
+
+func secureNotificationChannelSlackWithTemplateConfiguration(name string) string {
+	return fmt.Sprintf(`
+resource "sysdig_secure_notification_channel_slack" "sample-slack" {
+	name = "Example Channel %s - Slack"
+	enabled = true
+	url = "https://hooks.slack.cwom/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX"
+	channel = "#sysdig"
+	notify_when_ok = true
+	notify_when_resolved = true
+	template_configuration {
+		template_key = "SLACK_SECURE_EVENT_NOTIFICATION_TEMPLATE_METADATA_v2"
+		section {
+			name = "SECURE_EVENT_NOTIFICATION_CONTENT"
+			show = false
+		}
+	}
+}`, name)
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/spf13/cast"
 )
 
 func resourceSysdigSecureNotificationChannelSNS() *schema.Resource {
@@ -31,7 +30,7 @@ func resourceSysdigSecureNotificationChannelSNS() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"topics": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -52,7 +51,7 @@ func resourceSysdigSecureNotificationChannelSNSCreate(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelSNSFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelSNSFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,7 +82,7 @@ func resourceSysdigSecureNotificationChannelSNSRead(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelSNSToResourceData(&nc, d)
+	err = notificationChannelSNSToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -102,7 +101,7 @@ func resourceSysdigSecureNotificationChannelSNSUpdate(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelSNSFromResourceData(d, teamID)
+	nc, err := notificationChannelSNSFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -133,25 +132,3 @@ func resourceSysdigSecureNotificationChannelSNSDelete(ctx context.Context, d *sc
 
 	return nil
 }
-
-func secureNotificationChannelSNSFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_AMAZON_SNS
-	nc.Options.SnsTopicARNs = cast.ToStringSlice(d.Get("topics").(*schema.Set).List())
-	return
-}
-
-func secureNotificationChannelSNSToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("topics", nc.Options.SnsTopicARNs)
-
-	return
-}
@@ -31,7 +31,7 @@ func resourceSysdigSecureNotificationChannelTeamEmail() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchema(map[string]*schema.Schema{
 			"team_id": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -51,7 +51,7 @@ func resourceSysdigSecureNotificationChannelTeamEmailCreate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelTeamEmailFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelTeamEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -82,7 +82,7 @@ func resourceSysdigSecureNotificationChannelTeamEmailRead(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelTeamEmailToResourceData(&nc, d)
+	err = notificationChannelTeamEmailToResourceData(&nc, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -101,7 +101,7 @@ func resourceSysdigSecureNotificationChannelTeamEmailUpdate(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelTeamEmailFromResourceData(d, teamID)
+	nc, err := notificationChannelTeamEmailFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -132,25 +132,3 @@ func resourceSysdigSecureNotificationChannelTeamEmailDelete(ctx context.Context,
 
 	return nil
 }
-
-func secureNotificationChannelTeamEmailFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_TEAM_EMAIL
-	nc.Options.TeamId = d.Get("team_id").(int)
-	return
-}
-
-func secureNotificationChannelTeamEmailToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("team_id", nc.Options.TeamId)
-
-	return
-}
@@ -31,7 +31,7 @@ func resourceSysdigSecureNotificationChannelWebhook() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
-		Schema: createSecureNotificationChannelSchema(map[string]*schema.Schema{
+		Schema: createNotificationChannelSchemaWithSecrets(NOTIFICATION_CHANNEL_TYPE_WEBHOOK, map[string]*schema.Schema{
 			"url": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -64,7 +64,7 @@ func resourceSysdigSecureNotificationChannelWebhookCreate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	notificationChannel, err := secureNotificationChannelWebhookFromResourceData(d, teamID)
+	notificationChannel, err := notificationChannelWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -95,7 +95,7 @@ func resourceSysdigSecureNotificationChannelWebhookRead(ctx context.Context, d *
 		return diag.FromErr(err)
 	}
 
-	err = secureNotificationChannelWebhookToResourceData(&nc, d)
+	err = writeOnlyNotificationChannelToResourceData(&nc, d, notificationChannelWebhookToResourceData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -114,7 +114,7 @@ func resourceSysdigSecureNotificationChannelWebhookUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	nc, err := secureNotificationChannelWebhookFromResourceData(d, teamID)
+	nc, err := notificationChannelWebhookFromResourceData(d, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -127,7 +127,7 @@ func resourceSysdigSecureNotificationChannelWebhookUpdate(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return resourceSysdigSecureNotificationChannelWebhookRead(ctx, d, meta)
 }
 
 func resourceSysdigSecureNotificationChannelWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -145,34 +145,3 @@ func resourceSysdigSecureNotificationChannelWebhookDelete(ctx context.Context, d
 
 	return nil
 }
-
-func secureNotificationChannelWebhookFromResourceData(d *schema.ResourceData, teamID int) (nc v2.NotificationChannel, err error) {
-	nc, err = secureNotificationChannelFromResourceData(d, teamID)
-	if err != nil {
-		return
-	}
-
-	nc.Type = NOTIFICATION_CHANNEL_TYPE_WEBHOOK
-	nc.Options.Url = d.Get("url").(string)
-	nc.Options.AdditionalHeaders = d.Get("additional_headers").(map[string]interface{})
-	nc.Options.CustomData = d.Get("custom_data").(map[string]interface{})
-	allowInsecureConnections := d.Get("allow_insecure_connections").(bool)
-	nc.Options.AllowInsecureConnections = &allowInsecureConnections
-	return
-}
-
-func secureNotificationChannelWebhookToResourceData(nc *v2.NotificationChannel, d *schema.ResourceData) (err error) {
-	err = secureNotificationChannelToResourceData(nc, d)
-	if err != nil {
-		return
-	}
-
-	_ = d.Set("url", nc.Options.Url)
-	_ = d.Set("additional_headers", nc.Options.AdditionalHeaders)
-	_ = d.Set("custom_data", nc.Options.CustomData)
-	if nc.Options.AllowInsecureConnections != nil {
-		_ = d.Set("allow_insecure_connections", *nc.Options.AllowInsecureConnections)
-	}
-
-	return
-}
@@ -29,33 +29,37 @@ func TestAccSecureNotificationChannelWebhook(t *testing.T) {
 				Config: secureNotificationChannelWebhookWithName(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_webhook.sample-webhook",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_webhook.sample-webhook",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelWebhookSharedWithCurrentTeam(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_webhook.sample-webhook3",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_webhook.sample-webhook3",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelWebhookSharedWithAllowInsecureConnections(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_webhook.sample-webhook4",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_webhook.sample-webhook4",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 			{
 				Config: secureNotificationChannelWebhookSharedWithCustomData(rText()),
 			},
 			{
-				ResourceName:      "sysdig_secure_notification_channel_webhook.sample-webhook5",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "sysdig_secure_notification_channel_webhook.sample-webhook5",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_headers"},
 			},
 		},
 	})